@@ -0,0 +1,204 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        v3.19.1
+// source: block_pb/block.proto
+
+package block_pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Block of a historical read. Blocks older than the pruning window of the node need an archive node
+type AtBlock struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Block:
+	//	*AtBlock_Number
+	//	*AtBlock_Hash
+	//	*AtBlock_Timestamp
+	Block isAtBlock_Block `protobuf_oneof:"block"`
+}
+
+func (x *AtBlock) Reset() {
+	*x = AtBlock{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_block_pb_block_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AtBlock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AtBlock) ProtoMessage() {}
+
+func (x *AtBlock) ProtoReflect() protoreflect.Message {
+	mi := &file_block_pb_block_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AtBlock.ProtoReflect.Descriptor instead.
+func (*AtBlock) Descriptor() ([]byte, []int) {
+	return file_block_pb_block_proto_rawDescGZIP(), []int{0}
+}
+
+func (m *AtBlock) GetBlock() isAtBlock_Block {
+	if m != nil {
+		return m.Block
+	}
+	return nil
+}
+
+func (x *AtBlock) GetNumber() uint64 {
+	if x, ok := x.GetBlock().(*AtBlock_Number); ok {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *AtBlock) GetHash() string {
+	if x, ok := x.GetBlock().(*AtBlock_Hash); ok {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *AtBlock) GetTimestamp() uint64 {
+	if x, ok := x.GetBlock().(*AtBlock_Timestamp); ok {
+		return x.Timestamp
+	}
+	return 0
+}
+
+type isAtBlock_Block interface {
+	isAtBlock_Block()
+}
+
+type AtBlock_Number struct {
+	Number uint64 `protobuf:"varint,1,opt,name=number,proto3,oneof"`
+}
+
+type AtBlock_Hash struct {
+	// 0x hex, must be in the canonical chain
+	Hash string `protobuf:"bytes,2,opt,name=hash,proto3,oneof"`
+}
+
+type AtBlock_Timestamp struct {
+	// Unix seconds, the last block mined at or before it
+	Timestamp uint64 `protobuf:"varint,3,opt,name=timestamp,proto3,oneof"`
+}
+
+func (*AtBlock_Number) isAtBlock_Block() {}
+
+func (*AtBlock_Hash) isAtBlock_Block() {}
+
+func (*AtBlock_Timestamp) isAtBlock_Block() {}
+
+var File_block_pb_block_proto protoreflect.FileDescriptor
+
+var file_block_pb_block_proto_rawDesc = []byte{
+	0x0a, 0x14, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x70, 0x62, 0x2f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x70, 0x62,
+	0x22, 0x62, 0x0a, 0x07, 0x41, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x18, 0x0a, 0x06, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x06, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x1e, 0x0a, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00,
+	0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x07, 0x0a, 0x05, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x42, 0x5a, 0x40, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x6d, 0x69, 0x6e, 0x65, 0x6c, 0x6f, 0x6f, 0x70, 0x39, 0x39, 0x2f, 0x6e, 0x65,
+	0x77, 0x2d, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x65, 0x6e, 0x64,
+	0x2f, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x2f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2f,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_block_pb_block_proto_rawDescOnce sync.Once
+	file_block_pb_block_proto_rawDescData = file_block_pb_block_proto_rawDesc
+)
+
+func file_block_pb_block_proto_rawDescGZIP() []byte {
+	file_block_pb_block_proto_rawDescOnce.Do(func() {
+		file_block_pb_block_proto_rawDescData = protoimpl.X.CompressGZIP(file_block_pb_block_proto_rawDescData)
+	})
+	return file_block_pb_block_proto_rawDescData
+}
+
+var file_block_pb_block_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_block_pb_block_proto_goTypes = []interface{}{
+	(*AtBlock)(nil), // 0: block_pb.AtBlock
+}
+var file_block_pb_block_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_block_pb_block_proto_init() }
+func file_block_pb_block_proto_init() {
+	if File_block_pb_block_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_block_pb_block_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AtBlock); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_block_pb_block_proto_msgTypes[0].OneofWrappers = []interface{}{
+		(*AtBlock_Number)(nil),
+		(*AtBlock_Hash)(nil),
+		(*AtBlock_Timestamp)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_block_pb_block_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_block_pb_block_proto_goTypes,
+		DependencyIndexes: file_block_pb_block_proto_depIdxs,
+		MessageInfos:      file_block_pb_block_proto_msgTypes,
+	}.Build()
+	File_block_pb_block_proto = out.File
+	file_block_pb_block_proto_rawDesc = nil
+	file_block_pb_block_proto_goTypes = nil
+	file_block_pb_block_proto_depIdxs = nil
+}
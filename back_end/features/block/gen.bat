@@ -0,0 +1 @@
+start protoc --go_out=. --go_opt=module=github.com/mineloop99/new-token/back_end/features/block ./block_pb/block.proto
\ No newline at end of file
@@ -0,0 +1,262 @@
+package events
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/mineloop99/new-token/back_end/features/events/events_pb"
+	"github.com/mineloop99/new-token/back_end/indexer"
+	"github.com/mineloop99/new-token/back_end/utils"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Notifications the indexer can queue for a stream, past this the client is a slow consumer
+const SUBSCRIPTION_BUFFER = 256
+
+// A replay restarts when the indexer dropped the subscription meanwhile, the client is a slow
+// consumer after this many restarts
+const MAX_REPLAY_PASSES = 5
+
+type Server struct {
+	events_pb.UnimplementedEventServiceServer
+}
+
+func RewardRegister(s grpc.ServiceRegistrar) {
+	events_pb.RegisterEventServiceServer(s, &Server{})
+}
+
+// position orders the events in the chain
+type position struct {
+	blockNumber uint64
+	logIndex    uint
+}
+
+func positionOf(event indexer.IndexedEvent) position {
+	return position{blockNumber: event.BlockNumber, logIndex: event.LogIndex}
+}
+
+func (p position) after(other position) bool {
+	return p.blockNumber > other.blockNumber || p.blockNumber == other.blockNumber && p.logIndex > other.logIndex
+}
+
+// eventKey tells apart the same log in two versions of a block
+func eventKey(event indexer.IndexedEvent) string {
+	return fmt.Sprintf("%s:%d", event.BlockHash.Hex(), event.LogIndex)
+}
+
+type eventFilter struct {
+	contract string
+	event    string
+	args     map[string]string
+}
+
+func (f eventFilter) match(event indexer.IndexedEvent) bool {
+	if event.Contract != f.contract || f.event != "" && event.Name != f.event {
+		return false
+	}
+	for name, value := range f.args {
+		if event.Args[name] != value {
+			return false
+		}
+	}
+	return true
+}
+
+func (*Server) SubscribeEvents(in *events_pb.SubscribeEventsRequest, stream events_pb.EventService_SubscribeEventsServer) error {
+	idx := indexer.GetIndexer()
+	if idx == nil {
+		return status.Error(codes.FailedPrecondition, "SubscribeEvents: The indexer is disabled")
+	}
+	config, err := utils.GetConfig()
+	if err != nil {
+		return status.Errorf(codes.Internal, "SubscribeEvents: Cannot get config: %v", err)
+	}
+	filter, err := newEventFilter(config, idx, in)
+	if err != nil {
+		return err
+	}
+	store := idx.Store()
+
+	send := func(event indexer.IndexedEvent, removed bool) error {
+		return stream.Send(&events_pb.EventNotification{
+			Contract:    event.Contract,
+			Event:       event.Name,
+			Args:        event.Args,
+			BlockNumber: event.BlockNumber,
+			BlockHash:   event.BlockHash.Hex(),
+			TxHash:      event.TxHash.Hex(),
+			LogIndex:    uint32(event.LogIndex),
+			Removed:     removed,
+			Cursor:      &events_pb.EventCursor{BlockNumber: event.BlockNumber, LogIndex: uint32(event.LogIndex)},
+		})
+	}
+
+	// Subscribe before reading the store so no event falls between the replay and the live
+	// notifications. The events stored after the subscription are in both, overlap dedupes them
+	var sub *indexer.Subscription
+	var first, replayed position
+	var overlap map[string]bool
+	for pass := 0; ; pass++ {
+		if pass == MAX_REPLAY_PASSES {
+			return status.Errorf(codes.ResourceExhausted, "SubscribeEvents: The client reads slower than the chain, resume after block %d log %d", replayed.blockNumber, replayed.logIndex)
+		}
+		sub = idx.Subscribe(SUBSCRIPTION_BUFFER)
+		cursor, _, err := store.Cursor()
+		if err != nil {
+			sub.Unsubscribe()
+			return status.Errorf(codes.Internal, "SubscribeEvents: Cannot read the indexer store: %v", err)
+		}
+		if pass == 0 {
+			if after := in.GetAfter(); after != nil {
+				first = position{blockNumber: after.GetBlockNumber(), logIndex: uint(after.GetLogIndex())}
+			} else {
+				first = position{blockNumber: cursor.Number, logIndex: math.MaxUint32}
+			}
+			replayed = first
+		}
+		overlap = make(map[string]bool)
+		var sendErr error
+		err = store.Events(replayed.blockNumber, func(event indexer.IndexedEvent) bool {
+			if !positionOf(event).after(replayed) {
+				return true
+			}
+			if filter.match(event) {
+				if sendErr = send(event, false); sendErr != nil {
+					return false
+				}
+			}
+			replayed = positionOf(event)
+			if event.BlockNumber > cursor.Number {
+				overlap[eventKey(event)] = true
+			}
+			return true
+		})
+		if sendErr != nil {
+			sub.Unsubscribe()
+			return sendErr
+		}
+		if err != nil {
+			sub.Unsubscribe()
+			return status.Errorf(codes.Internal, "SubscribeEvents: Cannot read the indexer store: %v", err)
+		}
+		if sub.Err() == nil {
+			break
+		}
+		sub.Unsubscribe()
+	}
+	defer sub.Unsubscribe()
+
+	ctx := stream.Context()
+	for {
+		select {
+		case notification, open := <-sub.Notifications():
+			if !open {
+				if errors.Is(sub.Err(), indexer.ErrSlowConsumer) {
+					return status.Errorf(codes.ResourceExhausted, "SubscribeEvents: The client reads slower than the chain, resume after the last received event")
+				}
+				return status.Error(codes.Unavailable, "SubscribeEvents: The indexer closed the subscription")
+			}
+			event := notification.Event
+			if !filter.match(event) {
+				continue
+			}
+			if notification.Removed {
+				// Only the events sent on this stream are retracted
+				if positionOf(event).after(first) {
+					if err := send(event, true); err != nil {
+						return err
+					}
+				}
+				continue
+			}
+			if overlap[eventKey(event)] {
+				delete(overlap, eventKey(event))
+				continue
+			}
+			if err := send(event, false); err != nil {
+				return err
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// newEventFilter checks the request against the indexed events and the ABI, and normalizes the
+// argument values to the indexer format
+func newEventFilter(config utils.Config, idx *indexer.Indexer, in *events_pb.SubscribeEventsRequest) (eventFilter, error) {
+	filter := eventFilter{contract: in.GetContract(), event: in.GetEvent(), args: make(map[string]string)}
+	contract, err := config.GetContract(filter.contract)
+	if err != nil {
+		return filter, utils.StatusError(err)
+	}
+	if filter.event != "" {
+		if _, ok := contract.ABI.Events[filter.event]; !ok {
+			return filter, utils.StatusError(fmt.Errorf("%s.%s: %w", filter.contract, filter.event, utils.ErrEventNotFound))
+		}
+	}
+	if !idx.Watched(filter.contract, filter.event) {
+		return filter, status.Errorf(codes.InvalidArgument, "SubscribeEvents: %s %s events are not indexed", filter.contract, filter.event)
+	}
+	if len(in.GetArgs()) == 0 {
+		return filter, nil
+	}
+	if filter.event == "" {
+		return filter, status.Error(codes.InvalidArgument, "SubscribeEvents: Filtering on arguments needs an event")
+	}
+	inputs := make(map[string]abi.Argument)
+	for _, input := range contract.ABI.Events[filter.event].Inputs {
+		if input.Indexed {
+			inputs[input.Name] = input
+		}
+	}
+	for name, value := range in.GetArgs() {
+		input, ok := inputs[name]
+		if !ok {
+			return filter, status.Errorf(codes.InvalidArgument, "SubscribeEvents: %q is not an indexed argument of %s", name, filter.event)
+		}
+		normalized, ok := normalizeArg(input.Type, value)
+		if !ok {
+			return filter, status.Errorf(codes.InvalidArgument, "SubscribeEvents: Invalid %s %q for %s", input.Type, value, name)
+		}
+		filter.args[name] = normalized
+	}
+	return filter, nil
+}
+
+// normalizeArg formats a filter value like the indexer formats the decoded argument. Indexed
+// strings, bytes and arrays are stored as their hash
+func normalizeArg(t abi.Type, value string) (string, bool) {
+	switch t.T {
+	case abi.AddressTy:
+		if !common.IsHexAddress(value) {
+			return "", false
+		}
+		return common.HexToAddress(value).Hex(), true
+	case abi.IntTy, abi.UintTy:
+		number, ok := new(big.Int).SetString(value, 10)
+		if !ok {
+			return "", false
+		}
+		return number.String(), true
+	case abi.BoolTy:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return "", false
+		}
+		return strconv.FormatBool(b), true
+	default:
+		if !strings.HasPrefix(value, "0x") {
+			return "", false
+		}
+		return strings.ToLower(value), true
+	}
+}
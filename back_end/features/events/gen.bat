@@ -0,0 +1 @@
+start protoc --go_out=. --go-grpc_out=. ./events_pb/events.proto
\ No newline at end of file
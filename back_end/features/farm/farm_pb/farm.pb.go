@@ -0,0 +1,1299 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        v3.19.1
+// source: farm_pb/farm.proto
+
+package farm_pb
+
+import (
+	block_pb "github.com/mineloop99/new-token/back_end/features/block/block_pb"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetStakerInfoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Staker string `protobuf:"bytes,1,opt,name=staker,proto3" json:"staker,omitempty"`
+	// Latest block when unset
+	At *block_pb.AtBlock `protobuf:"bytes,2,opt,name=at,proto3" json:"at,omitempty"`
+}
+
+func (x *GetStakerInfoRequest) Reset() {
+	*x = GetStakerInfoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_farm_pb_farm_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStakerInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStakerInfoRequest) ProtoMessage() {}
+
+func (x *GetStakerInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_farm_pb_farm_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStakerInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetStakerInfoRequest) Descriptor() ([]byte, []int) {
+	return file_farm_pb_farm_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetStakerInfoRequest) GetStaker() string {
+	if x != nil {
+		return x.Staker
+	}
+	return ""
+}
+
+func (x *GetStakerInfoRequest) GetAt() *block_pb.AtBlock {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+type FarmPosition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// AniwarFarm keeps the staked amount per token private, only its value is readable
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// Base 10, staked amount * price / 10^price_decimals
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *FarmPosition) Reset() {
+	*x = FarmPosition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_farm_pb_farm_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FarmPosition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FarmPosition) ProtoMessage() {}
+
+func (x *FarmPosition) ProtoReflect() protoreflect.Message {
+	mi := &file_farm_pb_farm_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FarmPosition.ProtoReflect.Descriptor instead.
+func (*FarmPosition) Descriptor() ([]byte, []int) {
+	return file_farm_pb_farm_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *FarmPosition) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *FarmPosition) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type GetStakerInfoResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Staker string `protobuf:"bytes,1,opt,name=staker,proto3" json:"staker,omitempty"`
+	// One position per allowed token the staker has a value in
+	Positions []*FarmPosition `protobuf:"bytes,2,rep,name=positions,proto3" json:"positions,omitempty"`
+	// Amounts are base 10 strings, in wei for BNB
+	BnbStakingBalance string `protobuf:"bytes,3,opt,name=bnb_staking_balance,json=bnbStakingBalance,proto3" json:"bnb_staking_balance,omitempty"`
+	TotalValue        string `protobuf:"bytes,4,opt,name=total_value,json=totalValue,proto3" json:"total_value,omitempty"`
+	// ANI the next IssueTokens pays the staker: calculateRewardDebt from the last stake to the read block
+	PendingReward string `protobuf:"bytes,5,opt,name=pending_reward,json=pendingReward,proto3" json:"pending_reward,omitempty"`
+	// Accrued by the stake changes, IssueTokens does not pay it
+	RewardDebt     string `protobuf:"bytes,6,opt,name=reward_debt,json=rewardDebt,proto3" json:"reward_debt,omitempty"`
+	TimeLastStaked uint64 `protobuf:"varint,7,opt,name=time_last_staked,json=timeLastStaked,proto3" json:"time_last_staked,omitempty"`
+}
+
+func (x *GetStakerInfoResponse) Reset() {
+	*x = GetStakerInfoResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_farm_pb_farm_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStakerInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStakerInfoResponse) ProtoMessage() {}
+
+func (x *GetStakerInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_farm_pb_farm_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStakerInfoResponse.ProtoReflect.Descriptor instead.
+func (*GetStakerInfoResponse) Descriptor() ([]byte, []int) {
+	return file_farm_pb_farm_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetStakerInfoResponse) GetStaker() string {
+	if x != nil {
+		return x.Staker
+	}
+	return ""
+}
+
+func (x *GetStakerInfoResponse) GetPositions() []*FarmPosition {
+	if x != nil {
+		return x.Positions
+	}
+	return nil
+}
+
+func (x *GetStakerInfoResponse) GetBnbStakingBalance() string {
+	if x != nil {
+		return x.BnbStakingBalance
+	}
+	return ""
+}
+
+func (x *GetStakerInfoResponse) GetTotalValue() string {
+	if x != nil {
+		return x.TotalValue
+	}
+	return ""
+}
+
+func (x *GetStakerInfoResponse) GetPendingReward() string {
+	if x != nil {
+		return x.PendingReward
+	}
+	return ""
+}
+
+func (x *GetStakerInfoResponse) GetRewardDebt() string {
+	if x != nil {
+		return x.RewardDebt
+	}
+	return ""
+}
+
+func (x *GetStakerInfoResponse) GetTimeLastStaked() uint64 {
+	if x != nil {
+		return x.TimeLastStaked
+	}
+	return 0
+}
+
+type ListAllowedTokensRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Latest block when unset
+	At *block_pb.AtBlock `protobuf:"bytes,1,opt,name=at,proto3" json:"at,omitempty"`
+}
+
+func (x *ListAllowedTokensRequest) Reset() {
+	*x = ListAllowedTokensRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_farm_pb_farm_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAllowedTokensRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAllowedTokensRequest) ProtoMessage() {}
+
+func (x *ListAllowedTokensRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_farm_pb_farm_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAllowedTokensRequest.ProtoReflect.Descriptor instead.
+func (*ListAllowedTokensRequest) Descriptor() ([]byte, []int) {
+	return file_farm_pb_farm_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListAllowedTokensRequest) GetAt() *block_pb.AtBlock {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+type AllowedToken struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// Zero address when no data feed is set
+	DataFeed string `protobuf:"bytes,2,opt,name=data_feed,json=dataFeed,proto3" json:"data_feed,omitempty"`
+}
+
+func (x *AllowedToken) Reset() {
+	*x = AllowedToken{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_farm_pb_farm_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AllowedToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllowedToken) ProtoMessage() {}
+
+func (x *AllowedToken) ProtoReflect() protoreflect.Message {
+	mi := &file_farm_pb_farm_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllowedToken.ProtoReflect.Descriptor instead.
+func (*AllowedToken) Descriptor() ([]byte, []int) {
+	return file_farm_pb_farm_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AllowedToken) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *AllowedToken) GetDataFeed() string {
+	if x != nil {
+		return x.DataFeed
+	}
+	return ""
+}
+
+type ListAllowedTokensResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tokens []*AllowedToken `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
+}
+
+func (x *ListAllowedTokensResponse) Reset() {
+	*x = ListAllowedTokensResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_farm_pb_farm_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAllowedTokensResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAllowedTokensResponse) ProtoMessage() {}
+
+func (x *ListAllowedTokensResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_farm_pb_farm_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAllowedTokensResponse.ProtoReflect.Descriptor instead.
+func (*ListAllowedTokensResponse) Descriptor() ([]byte, []int) {
+	return file_farm_pb_farm_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListAllowedTokensResponse) GetTokens() []*AllowedToken {
+	if x != nil {
+		return x.Tokens
+	}
+	return nil
+}
+
+type GetTokenValueRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// Latest block when unset
+	At *block_pb.AtBlock `protobuf:"bytes,2,opt,name=at,proto3" json:"at,omitempty"`
+}
+
+func (x *GetTokenValueRequest) Reset() {
+	*x = GetTokenValueRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_farm_pb_farm_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTokenValueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTokenValueRequest) ProtoMessage() {}
+
+func (x *GetTokenValueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_farm_pb_farm_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTokenValueRequest.ProtoReflect.Descriptor instead.
+func (*GetTokenValueRequest) Descriptor() ([]byte, []int) {
+	return file_farm_pb_farm_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetTokenValueRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *GetTokenValueRequest) GetAt() *block_pb.AtBlock {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+type GetTokenValueResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Price    string `protobuf:"bytes,1,opt,name=price,proto3" json:"price,omitempty"`
+	Decimals uint64 `protobuf:"varint,2,opt,name=decimals,proto3" json:"decimals,omitempty"`
+}
+
+func (x *GetTokenValueResponse) Reset() {
+	*x = GetTokenValueResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_farm_pb_farm_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTokenValueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTokenValueResponse) ProtoMessage() {}
+
+func (x *GetTokenValueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_farm_pb_farm_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTokenValueResponse.ProtoReflect.Descriptor instead.
+func (*GetTokenValueResponse) Descriptor() ([]byte, []int) {
+	return file_farm_pb_farm_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetTokenValueResponse) GetPrice() string {
+	if x != nil {
+		return x.Price
+	}
+	return ""
+}
+
+func (x *GetTokenValueResponse) GetDecimals() uint64 {
+	if x != nil {
+		return x.Decimals
+	}
+	return 0
+}
+
+type GetBnbValueRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Latest block when unset
+	At *block_pb.AtBlock `protobuf:"bytes,1,opt,name=at,proto3" json:"at,omitempty"`
+}
+
+func (x *GetBnbValueRequest) Reset() {
+	*x = GetBnbValueRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_farm_pb_farm_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBnbValueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBnbValueRequest) ProtoMessage() {}
+
+func (x *GetBnbValueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_farm_pb_farm_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBnbValueRequest.ProtoReflect.Descriptor instead.
+func (*GetBnbValueRequest) Descriptor() ([]byte, []int) {
+	return file_farm_pb_farm_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetBnbValueRequest) GetAt() *block_pb.AtBlock {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+type IsTokenAllowedRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// Latest block when unset
+	At *block_pb.AtBlock `protobuf:"bytes,2,opt,name=at,proto3" json:"at,omitempty"`
+}
+
+func (x *IsTokenAllowedRequest) Reset() {
+	*x = IsTokenAllowedRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_farm_pb_farm_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IsTokenAllowedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IsTokenAllowedRequest) ProtoMessage() {}
+
+func (x *IsTokenAllowedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_farm_pb_farm_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IsTokenAllowedRequest.ProtoReflect.Descriptor instead.
+func (*IsTokenAllowedRequest) Descriptor() ([]byte, []int) {
+	return file_farm_pb_farm_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *IsTokenAllowedRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *IsTokenAllowedRequest) GetAt() *block_pb.AtBlock {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+type IsTokenAllowedResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Allowed bool `protobuf:"varint,1,opt,name=allowed,proto3" json:"allowed,omitempty"`
+}
+
+func (x *IsTokenAllowedResponse) Reset() {
+	*x = IsTokenAllowedResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_farm_pb_farm_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IsTokenAllowedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IsTokenAllowedResponse) ProtoMessage() {}
+
+func (x *IsTokenAllowedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_farm_pb_farm_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IsTokenAllowedResponse.ProtoReflect.Descriptor instead.
+func (*IsTokenAllowedResponse) Descriptor() ([]byte, []int) {
+	return file_farm_pb_farm_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *IsTokenAllowedResponse) GetAllowed() bool {
+	if x != nil {
+		return x.Allowed
+	}
+	return false
+}
+
+type AddAllowedTokenRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (x *AddAllowedTokenRequest) Reset() {
+	*x = AddAllowedTokenRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_farm_pb_farm_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddAllowedTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddAllowedTokenRequest) ProtoMessage() {}
+
+func (x *AddAllowedTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_farm_pb_farm_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddAllowedTokenRequest.ProtoReflect.Descriptor instead.
+func (*AddAllowedTokenRequest) Descriptor() ([]byte, []int) {
+	return file_farm_pb_farm_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *AddAllowedTokenRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type SetApyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Base 10, in thousandths
+	Apy string `protobuf:"bytes,1,opt,name=apy,proto3" json:"apy,omitempty"`
+}
+
+func (x *SetApyRequest) Reset() {
+	*x = SetApyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_farm_pb_farm_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetApyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetApyRequest) ProtoMessage() {}
+
+func (x *SetApyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_farm_pb_farm_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetApyRequest.ProtoReflect.Descriptor instead.
+func (*SetApyRequest) Descriptor() ([]byte, []int) {
+	return file_farm_pb_farm_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SetApyRequest) GetApy() string {
+	if x != nil {
+		return x.Apy
+	}
+	return ""
+}
+
+type SetDataFeedContractRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token    string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	DataFeed string `protobuf:"bytes,2,opt,name=data_feed,json=dataFeed,proto3" json:"data_feed,omitempty"`
+}
+
+func (x *SetDataFeedContractRequest) Reset() {
+	*x = SetDataFeedContractRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_farm_pb_farm_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetDataFeedContractRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDataFeedContractRequest) ProtoMessage() {}
+
+func (x *SetDataFeedContractRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_farm_pb_farm_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDataFeedContractRequest.ProtoReflect.Descriptor instead.
+func (*SetDataFeedContractRequest) Descriptor() ([]byte, []int) {
+	return file_farm_pb_farm_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SetDataFeedContractRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *SetDataFeedContractRequest) GetDataFeed() string {
+	if x != nil {
+		return x.DataFeed
+	}
+	return ""
+}
+
+type IssueTokensRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *IssueTokensRequest) Reset() {
+	*x = IssueTokensRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_farm_pb_farm_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IssueTokensRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueTokensRequest) ProtoMessage() {}
+
+func (x *IssueTokensRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_farm_pb_farm_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueTokensRequest.ProtoReflect.Descriptor instead.
+func (*IssueTokensRequest) Descriptor() ([]byte, []int) {
+	return file_farm_pb_farm_proto_rawDescGZIP(), []int{14}
+}
+
+// The transaction is sent, its outcome is available from TransactionService
+type FarmTxResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TxHash string `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+}
+
+func (x *FarmTxResponse) Reset() {
+	*x = FarmTxResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_farm_pb_farm_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FarmTxResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FarmTxResponse) ProtoMessage() {}
+
+func (x *FarmTxResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_farm_pb_farm_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FarmTxResponse.ProtoReflect.Descriptor instead.
+func (*FarmTxResponse) Descriptor() ([]byte, []int) {
+	return file_farm_pb_farm_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *FarmTxResponse) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+var File_farm_pb_farm_proto protoreflect.FileDescriptor
+
+var file_farm_pb_farm_proto_rawDesc = []byte{
+	0x0a, 0x12, 0x66, 0x61, 0x72, 0x6d, 0x5f, 0x70, 0x62, 0x2f, 0x66, 0x61, 0x72, 0x6d, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x66, 0x61, 0x72, 0x6d, 0x5f, 0x70, 0x62, 0x1a, 0x1a, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x2f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x70, 0x62, 0x2f, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x51, 0x0a, 0x14, 0x47, 0x65, 0x74,
+	0x53, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x02, 0x61, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x70, 0x62,
+	0x2e, 0x41, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x02, 0x61, 0x74, 0x22, 0x3a, 0x0a, 0x0c,
+	0x46, 0x61, 0x72, 0x6d, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xa7, 0x02, 0x0a, 0x15, 0x47, 0x65, 0x74,
+	0x53, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x12, 0x33, 0x0a, 0x09, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x66, 0x61, 0x72, 0x6d, 0x5f, 0x70, 0x62, 0x2e, 0x46, 0x61, 0x72, 0x6d, 0x50, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x2e, 0x0a, 0x13, 0x62, 0x6e, 0x62, 0x5f, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x62,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x62, 0x6e,
+	0x62, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12,
+	0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x25, 0x0a, 0x0e, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x5f, 0x64, 0x65, 0x62, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x44, 0x65, 0x62, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x74, 0x69, 0x6d, 0x65,
+	0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0e, 0x74, 0x69, 0x6d, 0x65, 0x4c, 0x61, 0x73, 0x74, 0x53, 0x74, 0x61, 0x6b,
+	0x65, 0x64, 0x22, 0x3d, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65,
+	0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21,
+	0x0a, 0x02, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x5f, 0x70, 0x62, 0x2e, 0x41, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x02, 0x61,
+	0x74, 0x22, 0x41, 0x0a, 0x0c, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x61, 0x74, 0x61, 0x5f,
+	0x66, 0x65, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61,
+	0x46, 0x65, 0x65, 0x64, 0x22, 0x4a, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x6f,
+	0x77, 0x65, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2d, 0x0a, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x66, 0x61, 0x72, 0x6d, 0x5f, 0x70, 0x62, 0x2e, 0x41, 0x6c, 0x6c, 0x6f,
+	0x77, 0x65, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73,
+	0x22, 0x4f, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x21,
+	0x0a, 0x02, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x5f, 0x70, 0x62, 0x2e, 0x41, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x02, 0x61,
+	0x74, 0x22, 0x49, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x08, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x73, 0x22, 0x37, 0x0a, 0x12,
+	0x47, 0x65, 0x74, 0x42, 0x6e, 0x62, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x21, 0x0a, 0x02, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x70, 0x62, 0x2e, 0x41, 0x74, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x52, 0x02, 0x61, 0x74, 0x22, 0x50, 0x0a, 0x15, 0x49, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x21, 0x0a, 0x02, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x70, 0x62, 0x2e, 0x41, 0x74, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x52, 0x02, 0x61, 0x74, 0x22, 0x32, 0x0a, 0x16, 0x49, 0x73, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x22, 0x2e, 0x0a, 0x16, 0x41,
+	0x64, 0x64, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x21, 0x0a, 0x0d, 0x53,
+	0x65, 0x74, 0x41, 0x70, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03,
+	0x61, 0x70, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x61, 0x70, 0x79, 0x22, 0x4f,
+	0x0a, 0x1a, 0x53, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x46, 0x65, 0x65, 0x64, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x46, 0x65, 0x65, 0x64, 0x22,
+	0x14, 0x0a, 0x12, 0x49, 0x73, 0x73, 0x75, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x29, 0x0a, 0x0e, 0x46, 0x61, 0x72, 0x6d, 0x54, 0x78, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x78, 0x5f, 0x68, 0x61,
+	0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68,
+	0x32, 0xca, 0x05, 0x0a, 0x0b, 0x46, 0x61, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x4e, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x1d, 0x2e, 0x66, 0x61, 0x72, 0x6d, 0x5f, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x53,
+	0x74, 0x61, 0x6b, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1e, 0x2e, 0x66, 0x61, 0x72, 0x6d, 0x5f, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74,
+	0x61, 0x6b, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x5a, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x21, 0x2e, 0x66, 0x61, 0x72, 0x6d, 0x5f, 0x70, 0x62, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x66, 0x61, 0x72, 0x6d, 0x5f,
+	0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x0d,
+	0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1d, 0x2e,
+	0x66, 0x61, 0x72, 0x6d, 0x5f, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x66,
+	0x61, 0x72, 0x6d, 0x5f, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0b,
+	0x47, 0x65, 0x74, 0x42, 0x6e, 0x62, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1b, 0x2e, 0x66, 0x61,
+	0x72, 0x6d, 0x5f, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6e, 0x62, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x66, 0x61, 0x72, 0x6d, 0x5f,
+	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x0e, 0x49, 0x73, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x12, 0x1e, 0x2e, 0x66, 0x61, 0x72,
+	0x6d, 0x5f, 0x70, 0x62, 0x2e, 0x49, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x41, 0x6c, 0x6c, 0x6f,
+	0x77, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x66, 0x61, 0x72,
+	0x6d, 0x5f, 0x70, 0x62, 0x2e, 0x49, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x41, 0x6c, 0x6c, 0x6f,
+	0x77, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x0f, 0x41,
+	0x64, 0x64, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1f,
+	0x2e, 0x66, 0x61, 0x72, 0x6d, 0x5f, 0x70, 0x62, 0x2e, 0x41, 0x64, 0x64, 0x41, 0x6c, 0x6c, 0x6f,
+	0x77, 0x65, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x17, 0x2e, 0x66, 0x61, 0x72, 0x6d, 0x5f, 0x70, 0x62, 0x2e, 0x46, 0x61, 0x72, 0x6d, 0x54, 0x78,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x06, 0x53, 0x65, 0x74, 0x41,
+	0x70, 0x79, 0x12, 0x16, 0x2e, 0x66, 0x61, 0x72, 0x6d, 0x5f, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74,
+	0x41, 0x70, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x66, 0x61, 0x72,
+	0x6d, 0x5f, 0x70, 0x62, 0x2e, 0x46, 0x61, 0x72, 0x6d, 0x54, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x46, 0x65,
+	0x65, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x23, 0x2e, 0x66, 0x61, 0x72,
+	0x6d, 0x5f, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x46, 0x65, 0x65, 0x64,
+	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x17, 0x2e, 0x66, 0x61, 0x72, 0x6d, 0x5f, 0x70, 0x62, 0x2e, 0x46, 0x61, 0x72, 0x6d, 0x54, 0x78,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0b, 0x49, 0x73, 0x73, 0x75,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x1b, 0x2e, 0x66, 0x61, 0x72, 0x6d, 0x5f, 0x70,
+	0x62, 0x2e, 0x49, 0x73, 0x73, 0x75, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x66, 0x61, 0x72, 0x6d, 0x5f, 0x70, 0x62, 0x2e, 0x46,
+	0x61, 0x72, 0x6d, 0x54, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x0a, 0x5a,
+	0x08, 0x2f, 0x66, 0x61, 0x72, 0x6d, 0x5f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_farm_pb_farm_proto_rawDescOnce sync.Once
+	file_farm_pb_farm_proto_rawDescData = file_farm_pb_farm_proto_rawDesc
+)
+
+func file_farm_pb_farm_proto_rawDescGZIP() []byte {
+	file_farm_pb_farm_proto_rawDescOnce.Do(func() {
+		file_farm_pb_farm_proto_rawDescData = protoimpl.X.CompressGZIP(file_farm_pb_farm_proto_rawDescData)
+	})
+	return file_farm_pb_farm_proto_rawDescData
+}
+
+var file_farm_pb_farm_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_farm_pb_farm_proto_goTypes = []interface{}{
+	(*GetStakerInfoRequest)(nil),       // 0: farm_pb.GetStakerInfoRequest
+	(*FarmPosition)(nil),               // 1: farm_pb.FarmPosition
+	(*GetStakerInfoResponse)(nil),      // 2: farm_pb.GetStakerInfoResponse
+	(*ListAllowedTokensRequest)(nil),   // 3: farm_pb.ListAllowedTokensRequest
+	(*AllowedToken)(nil),               // 4: farm_pb.AllowedToken
+	(*ListAllowedTokensResponse)(nil),  // 5: farm_pb.ListAllowedTokensResponse
+	(*GetTokenValueRequest)(nil),       // 6: farm_pb.GetTokenValueRequest
+	(*GetTokenValueResponse)(nil),      // 7: farm_pb.GetTokenValueResponse
+	(*GetBnbValueRequest)(nil),         // 8: farm_pb.GetBnbValueRequest
+	(*IsTokenAllowedRequest)(nil),      // 9: farm_pb.IsTokenAllowedRequest
+	(*IsTokenAllowedResponse)(nil),     // 10: farm_pb.IsTokenAllowedResponse
+	(*AddAllowedTokenRequest)(nil),     // 11: farm_pb.AddAllowedTokenRequest
+	(*SetApyRequest)(nil),              // 12: farm_pb.SetApyRequest
+	(*SetDataFeedContractRequest)(nil), // 13: farm_pb.SetDataFeedContractRequest
+	(*IssueTokensRequest)(nil),         // 14: farm_pb.IssueTokensRequest
+	(*FarmTxResponse)(nil),             // 15: farm_pb.FarmTxResponse
+	(*block_pb.AtBlock)(nil),           // 16: block_pb.AtBlock
+}
+var file_farm_pb_farm_proto_depIdxs = []int32{
+	16, // 0: farm_pb.GetStakerInfoRequest.at:type_name -> block_pb.AtBlock
+	1,  // 1: farm_pb.GetStakerInfoResponse.positions:type_name -> farm_pb.FarmPosition
+	16, // 2: farm_pb.ListAllowedTokensRequest.at:type_name -> block_pb.AtBlock
+	4,  // 3: farm_pb.ListAllowedTokensResponse.tokens:type_name -> farm_pb.AllowedToken
+	16, // 4: farm_pb.GetTokenValueRequest.at:type_name -> block_pb.AtBlock
+	16, // 5: farm_pb.GetBnbValueRequest.at:type_name -> block_pb.AtBlock
+	16, // 6: farm_pb.IsTokenAllowedRequest.at:type_name -> block_pb.AtBlock
+	0,  // 7: farm_pb.FarmService.GetStakerInfo:input_type -> farm_pb.GetStakerInfoRequest
+	3,  // 8: farm_pb.FarmService.ListAllowedTokens:input_type -> farm_pb.ListAllowedTokensRequest
+	6,  // 9: farm_pb.FarmService.GetTokenValue:input_type -> farm_pb.GetTokenValueRequest
+	8,  // 10: farm_pb.FarmService.GetBnbValue:input_type -> farm_pb.GetBnbValueRequest
+	9,  // 11: farm_pb.FarmService.IsTokenAllowed:input_type -> farm_pb.IsTokenAllowedRequest
+	11, // 12: farm_pb.FarmService.AddAllowedToken:input_type -> farm_pb.AddAllowedTokenRequest
+	12, // 13: farm_pb.FarmService.SetApy:input_type -> farm_pb.SetApyRequest
+	13, // 14: farm_pb.FarmService.SetDataFeedContract:input_type -> farm_pb.SetDataFeedContractRequest
+	14, // 15: farm_pb.FarmService.IssueTokens:input_type -> farm_pb.IssueTokensRequest
+	2,  // 16: farm_pb.FarmService.GetStakerInfo:output_type -> farm_pb.GetStakerInfoResponse
+	5,  // 17: farm_pb.FarmService.ListAllowedTokens:output_type -> farm_pb.ListAllowedTokensResponse
+	7,  // 18: farm_pb.FarmService.GetTokenValue:output_type -> farm_pb.GetTokenValueResponse
+	7,  // 19: farm_pb.FarmService.GetBnbValue:output_type -> farm_pb.GetTokenValueResponse
+	10, // 20: farm_pb.FarmService.IsTokenAllowed:output_type -> farm_pb.IsTokenAllowedResponse
+	15, // 21: farm_pb.FarmService.AddAllowedToken:output_type -> farm_pb.FarmTxResponse
+	15, // 22: farm_pb.FarmService.SetApy:output_type -> farm_pb.FarmTxResponse
+	15, // 23: farm_pb.FarmService.SetDataFeedContract:output_type -> farm_pb.FarmTxResponse
+	15, // 24: farm_pb.FarmService.IssueTokens:output_type -> farm_pb.FarmTxResponse
+	16, // [16:25] is the sub-list for method output_type
+	7,  // [7:16] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_farm_pb_farm_proto_init() }
+func file_farm_pb_farm_proto_init() {
+	if File_farm_pb_farm_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_farm_pb_farm_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStakerInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_farm_pb_farm_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FarmPosition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_farm_pb_farm_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStakerInfoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_farm_pb_farm_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAllowedTokensRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_farm_pb_farm_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AllowedToken); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_farm_pb_farm_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAllowedTokensResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_farm_pb_farm_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTokenValueRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_farm_pb_farm_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTokenValueResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_farm_pb_farm_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBnbValueRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_farm_pb_farm_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IsTokenAllowedRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_farm_pb_farm_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IsTokenAllowedResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_farm_pb_farm_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddAllowedTokenRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_farm_pb_farm_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetApyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_farm_pb_farm_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetDataFeedContractRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_farm_pb_farm_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IssueTokensRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_farm_pb_farm_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FarmTxResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_farm_pb_farm_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   16,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_farm_pb_farm_proto_goTypes,
+		DependencyIndexes: file_farm_pb_farm_proto_depIdxs,
+		MessageInfos:      file_farm_pb_farm_proto_msgTypes,
+	}.Build()
+	File_farm_pb_farm_proto = out.File
+	file_farm_pb_farm_proto_rawDesc = nil
+	file_farm_pb_farm_proto_goTypes = nil
+	file_farm_pb_farm_proto_depIdxs = nil
+}
@@ -0,0 +1,401 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package farm_pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// FarmServiceClient is the client API for FarmService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type FarmServiceClient interface {
+	// Returns the staking positions of a staker on AniwarFarm, valued with the token data feeds
+	GetStakerInfo(ctx context.Context, in *GetStakerInfoRequest, opts ...grpc.CallOption) (*GetStakerInfoResponse, error)
+	// Lists the tokens AniwarFarm accepts for staking
+	ListAllowedTokens(ctx context.Context, in *ListAllowedTokensRequest, opts ...grpc.CallOption) (*ListAllowedTokensResponse, error)
+	// Returns the data feed price of a token
+	GetTokenValue(ctx context.Context, in *GetTokenValueRequest, opts ...grpc.CallOption) (*GetTokenValueResponse, error)
+	// Returns the BNB/USD data feed price staked BNB is valued with
+	GetBnbValue(ctx context.Context, in *GetBnbValueRequest, opts ...grpc.CallOption) (*GetTokenValueResponse, error)
+	IsTokenAllowed(ctx context.Context, in *IsTokenAllowedRequest, opts ...grpc.CallOption) (*IsTokenAllowedResponse, error)
+	// Admin methods, sent from the signer routed to AniwarFarm
+	AddAllowedToken(ctx context.Context, in *AddAllowedTokenRequest, opts ...grpc.CallOption) (*FarmTxResponse, error)
+	SetApy(ctx context.Context, in *SetApyRequest, opts ...grpc.CallOption) (*FarmTxResponse, error)
+	SetDataFeedContract(ctx context.Context, in *SetDataFeedContractRequest, opts ...grpc.CallOption) (*FarmTxResponse, error)
+	// Pays every staker the ANI accrued since its last stake, see GetStakerInfoResponse.pending_reward
+	IssueTokens(ctx context.Context, in *IssueTokensRequest, opts ...grpc.CallOption) (*FarmTxResponse, error)
+}
+
+type farmServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewFarmServiceClient(cc grpc.ClientConnInterface) FarmServiceClient {
+	return &farmServiceClient{cc}
+}
+
+func (c *farmServiceClient) GetStakerInfo(ctx context.Context, in *GetStakerInfoRequest, opts ...grpc.CallOption) (*GetStakerInfoResponse, error) {
+	out := new(GetStakerInfoResponse)
+	err := c.cc.Invoke(ctx, "/farm_pb.FarmService/GetStakerInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *farmServiceClient) ListAllowedTokens(ctx context.Context, in *ListAllowedTokensRequest, opts ...grpc.CallOption) (*ListAllowedTokensResponse, error) {
+	out := new(ListAllowedTokensResponse)
+	err := c.cc.Invoke(ctx, "/farm_pb.FarmService/ListAllowedTokens", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *farmServiceClient) GetTokenValue(ctx context.Context, in *GetTokenValueRequest, opts ...grpc.CallOption) (*GetTokenValueResponse, error) {
+	out := new(GetTokenValueResponse)
+	err := c.cc.Invoke(ctx, "/farm_pb.FarmService/GetTokenValue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *farmServiceClient) GetBnbValue(ctx context.Context, in *GetBnbValueRequest, opts ...grpc.CallOption) (*GetTokenValueResponse, error) {
+	out := new(GetTokenValueResponse)
+	err := c.cc.Invoke(ctx, "/farm_pb.FarmService/GetBnbValue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *farmServiceClient) IsTokenAllowed(ctx context.Context, in *IsTokenAllowedRequest, opts ...grpc.CallOption) (*IsTokenAllowedResponse, error) {
+	out := new(IsTokenAllowedResponse)
+	err := c.cc.Invoke(ctx, "/farm_pb.FarmService/IsTokenAllowed", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *farmServiceClient) AddAllowedToken(ctx context.Context, in *AddAllowedTokenRequest, opts ...grpc.CallOption) (*FarmTxResponse, error) {
+	out := new(FarmTxResponse)
+	err := c.cc.Invoke(ctx, "/farm_pb.FarmService/AddAllowedToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *farmServiceClient) SetApy(ctx context.Context, in *SetApyRequest, opts ...grpc.CallOption) (*FarmTxResponse, error) {
+	out := new(FarmTxResponse)
+	err := c.cc.Invoke(ctx, "/farm_pb.FarmService/SetApy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *farmServiceClient) SetDataFeedContract(ctx context.Context, in *SetDataFeedContractRequest, opts ...grpc.CallOption) (*FarmTxResponse, error) {
+	out := new(FarmTxResponse)
+	err := c.cc.Invoke(ctx, "/farm_pb.FarmService/SetDataFeedContract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *farmServiceClient) IssueTokens(ctx context.Context, in *IssueTokensRequest, opts ...grpc.CallOption) (*FarmTxResponse, error) {
+	out := new(FarmTxResponse)
+	err := c.cc.Invoke(ctx, "/farm_pb.FarmService/IssueTokens", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// FarmServiceServer is the server API for FarmService service.
+// All implementations must embed UnimplementedFarmServiceServer
+// for forward compatibility
+type FarmServiceServer interface {
+	// Returns the staking positions of a staker on AniwarFarm, valued with the token data feeds
+	GetStakerInfo(context.Context, *GetStakerInfoRequest) (*GetStakerInfoResponse, error)
+	// Lists the tokens AniwarFarm accepts for staking
+	ListAllowedTokens(context.Context, *ListAllowedTokensRequest) (*ListAllowedTokensResponse, error)
+	// Returns the data feed price of a token
+	GetTokenValue(context.Context, *GetTokenValueRequest) (*GetTokenValueResponse, error)
+	// Returns the BNB/USD data feed price staked BNB is valued with
+	GetBnbValue(context.Context, *GetBnbValueRequest) (*GetTokenValueResponse, error)
+	IsTokenAllowed(context.Context, *IsTokenAllowedRequest) (*IsTokenAllowedResponse, error)
+	// Admin methods, sent from the signer routed to AniwarFarm
+	AddAllowedToken(context.Context, *AddAllowedTokenRequest) (*FarmTxResponse, error)
+	SetApy(context.Context, *SetApyRequest) (*FarmTxResponse, error)
+	SetDataFeedContract(context.Context, *SetDataFeedContractRequest) (*FarmTxResponse, error)
+	// Pays every staker the ANI accrued since its last stake, see GetStakerInfoResponse.pending_reward
+	IssueTokens(context.Context, *IssueTokensRequest) (*FarmTxResponse, error)
+	mustEmbedUnimplementedFarmServiceServer()
+}
+
+// UnimplementedFarmServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedFarmServiceServer struct {
+}
+
+func (UnimplementedFarmServiceServer) GetStakerInfo(context.Context, *GetStakerInfoRequest) (*GetStakerInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStakerInfo not implemented")
+}
+func (UnimplementedFarmServiceServer) ListAllowedTokens(context.Context, *ListAllowedTokensRequest) (*ListAllowedTokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAllowedTokens not implemented")
+}
+func (UnimplementedFarmServiceServer) GetTokenValue(context.Context, *GetTokenValueRequest) (*GetTokenValueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTokenValue not implemented")
+}
+func (UnimplementedFarmServiceServer) GetBnbValue(context.Context, *GetBnbValueRequest) (*GetTokenValueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBnbValue not implemented")
+}
+func (UnimplementedFarmServiceServer) IsTokenAllowed(context.Context, *IsTokenAllowedRequest) (*IsTokenAllowedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsTokenAllowed not implemented")
+}
+func (UnimplementedFarmServiceServer) AddAllowedToken(context.Context, *AddAllowedTokenRequest) (*FarmTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddAllowedToken not implemented")
+}
+func (UnimplementedFarmServiceServer) SetApy(context.Context, *SetApyRequest) (*FarmTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetApy not implemented")
+}
+func (UnimplementedFarmServiceServer) SetDataFeedContract(context.Context, *SetDataFeedContractRequest) (*FarmTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDataFeedContract not implemented")
+}
+func (UnimplementedFarmServiceServer) IssueTokens(context.Context, *IssueTokensRequest) (*FarmTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IssueTokens not implemented")
+}
+func (UnimplementedFarmServiceServer) mustEmbedUnimplementedFarmServiceServer() {}
+
+// UnsafeFarmServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to FarmServiceServer will
+// result in compilation errors.
+type UnsafeFarmServiceServer interface {
+	mustEmbedUnimplementedFarmServiceServer()
+}
+
+func RegisterFarmServiceServer(s grpc.ServiceRegistrar, srv FarmServiceServer) {
+	s.RegisterService(&FarmService_ServiceDesc, srv)
+}
+
+func _FarmService_GetStakerInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStakerInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FarmServiceServer).GetStakerInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/farm_pb.FarmService/GetStakerInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FarmServiceServer).GetStakerInfo(ctx, req.(*GetStakerInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FarmService_ListAllowedTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAllowedTokensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FarmServiceServer).ListAllowedTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/farm_pb.FarmService/ListAllowedTokens",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FarmServiceServer).ListAllowedTokens(ctx, req.(*ListAllowedTokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FarmService_GetTokenValue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTokenValueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FarmServiceServer).GetTokenValue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/farm_pb.FarmService/GetTokenValue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FarmServiceServer).GetTokenValue(ctx, req.(*GetTokenValueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FarmService_GetBnbValue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBnbValueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FarmServiceServer).GetBnbValue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/farm_pb.FarmService/GetBnbValue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FarmServiceServer).GetBnbValue(ctx, req.(*GetBnbValueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FarmService_IsTokenAllowed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IsTokenAllowedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FarmServiceServer).IsTokenAllowed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/farm_pb.FarmService/IsTokenAllowed",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FarmServiceServer).IsTokenAllowed(ctx, req.(*IsTokenAllowedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FarmService_AddAllowedToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddAllowedTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FarmServiceServer).AddAllowedToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/farm_pb.FarmService/AddAllowedToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FarmServiceServer).AddAllowedToken(ctx, req.(*AddAllowedTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FarmService_SetApy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetApyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FarmServiceServer).SetApy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/farm_pb.FarmService/SetApy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FarmServiceServer).SetApy(ctx, req.(*SetApyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FarmService_SetDataFeedContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDataFeedContractRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FarmServiceServer).SetDataFeedContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/farm_pb.FarmService/SetDataFeedContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FarmServiceServer).SetDataFeedContract(ctx, req.(*SetDataFeedContractRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FarmService_IssueTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IssueTokensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FarmServiceServer).IssueTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/farm_pb.FarmService/IssueTokens",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FarmServiceServer).IssueTokens(ctx, req.(*IssueTokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// FarmService_ServiceDesc is the grpc.ServiceDesc for FarmService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var FarmService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "farm_pb.FarmService",
+	HandlerType: (*FarmServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetStakerInfo",
+			Handler:    _FarmService_GetStakerInfo_Handler,
+		},
+		{
+			MethodName: "ListAllowedTokens",
+			Handler:    _FarmService_ListAllowedTokens_Handler,
+		},
+		{
+			MethodName: "GetTokenValue",
+			Handler:    _FarmService_GetTokenValue_Handler,
+		},
+		{
+			MethodName: "GetBnbValue",
+			Handler:    _FarmService_GetBnbValue_Handler,
+		},
+		{
+			MethodName: "IsTokenAllowed",
+			Handler:    _FarmService_IsTokenAllowed_Handler,
+		},
+		{
+			MethodName: "AddAllowedToken",
+			Handler:    _FarmService_AddAllowedToken_Handler,
+		},
+		{
+			MethodName: "SetApy",
+			Handler:    _FarmService_SetApy_Handler,
+		},
+		{
+			MethodName: "SetDataFeedContract",
+			Handler:    _FarmService_SetDataFeedContract_Handler,
+		},
+		{
+			MethodName: "IssueTokens",
+			Handler:    _FarmService_IssueTokens_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "farm_pb/farm.proto",
+}
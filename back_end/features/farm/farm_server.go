@@ -0,0 +1,309 @@
+package farm
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/mineloop99/new-token/back_end/features/farm/farm_pb"
+	"github.com/mineloop99/new-token/back_end/utils"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// allowedTokens has no length getter, it is read in batches until an index reverts
+const ALLOWED_TOKENS_BATCH = 20
+const MAX_ALLOWED_TOKENS = 1000
+
+type Server struct {
+	farm_pb.UnimplementedFarmServiceServer
+}
+
+func RewardRegister(s grpc.ServiceRegistrar) {
+	farm_pb.RegisterFarmServiceServer(s, &Server{})
+}
+
+func (*Server) GetStakerInfo(ctx context.Context, in *farm_pb.GetStakerInfoRequest) (*farm_pb.GetStakerInfoResponse, error) {
+	config, err := utils.GetConfig()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "GetStakerInfo: Cannot get config: %v", err)
+	}
+	staker, err := parseAddress("GetStakerInfo", in.GetStaker())
+	if err != nil {
+		return nil, err
+	}
+	config, err = utils.AtBlock(ctx, config, in.GetAt())
+	if err != nil {
+		return nil, utils.StatusError(err)
+	}
+	tokens, err := allowedTokens(config)
+	if err != nil {
+		return nil, utils.StatusError(err)
+	}
+
+	// The staked amount per token is private, the positions are valued with the token data feeds
+	var valueCalls []*utils.ViewCall
+	for _, token := range tokens {
+		valueCalls = append(valueCalls, &utils.ViewCall{ContractName: utils.ANIWAR_FARM, MethodName: "getUserSingleTokenValue", Args: []interface{}{staker, token}})
+	}
+	err = utils.BatchCallViewMethods(config, valueCalls)
+	if err != nil {
+		return nil, utils.StatusError(err)
+	}
+	var positions []*farm_pb.FarmPosition
+	for i, call := range valueCalls {
+		if call.Err != nil {
+			return nil, utils.StatusError(call.Err)
+		}
+		value := call.Result[0].(*big.Int)
+		if value.Sign() == 0 {
+			continue
+		}
+		positions = append(positions, &farm_pb.FarmPosition{Token: tokens[i].Hex(), Value: value.String()})
+	}
+
+	// stakersInfo returns timeLastStaked, stakingBnbBalance and rewardDebt, the token balances mapping is left out
+	result, err := utils.CallViewMethods(config, utils.ANIWAR_FARM, "stakersInfo", big.NewInt(0), staker)
+	if err != nil {
+		return nil, utils.StatusError(err)
+	}
+	timeLastStaked := result[0].(*big.Int)
+	bnbBalance := result[1].(*big.Int)
+	rewardDebt := result[2].(*big.Int)
+
+	totalValue := big.NewInt(0)
+	pendingReward := big.NewInt(0)
+	result, err = utils.CallViewMethods(config, utils.ANIWAR_FARM, "getUserTotalValue", big.NewInt(0), staker)
+	if err == nil {
+		totalValue = result[0].(*big.Int)
+		// issueTokens pays calculateRewardDebt from the last stake to block.timestamp
+		header, err := config.Header(ctx)
+		if err != nil {
+			return nil, utils.StatusError(err)
+		}
+		now := new(big.Int).SetUint64(header.Time)
+		if now.Cmp(timeLastStaked) > 0 {
+			result, err = utils.CallViewMethods(config, utils.ANIWAR_FARM, "calculateRewardDebt", big.NewInt(0), staker, timeLastStaked, now)
+			if err != nil {
+				return nil, utils.StatusError(err)
+			}
+			pendingReward = result[0].(*big.Int)
+		}
+	} else if !isNothingStaked(err) {
+		return nil, utils.StatusError(err)
+	}
+
+	return &farm_pb.GetStakerInfoResponse{
+		Staker:            staker.Hex(),
+		Positions:         positions,
+		BnbStakingBalance: bnbBalance.String(),
+		TotalValue:        totalValue.String(),
+		PendingReward:     pendingReward.String(),
+		RewardDebt:        rewardDebt.String(),
+		TimeLastStaked:    timeLastStaked.Uint64(),
+	}, nil
+}
+
+func (*Server) ListAllowedTokens(ctx context.Context, in *farm_pb.ListAllowedTokensRequest) (*farm_pb.ListAllowedTokensResponse, error) {
+	config, err := utils.GetConfig()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "ListAllowedTokens: Cannot get config: %v", err)
+	}
+	config, err = utils.AtBlock(ctx, config, in.GetAt())
+	if err != nil {
+		return nil, utils.StatusError(err)
+	}
+	tokens, err := allowedTokens(config)
+	if err != nil {
+		return nil, utils.StatusError(err)
+	}
+	var feedCalls []*utils.ViewCall
+	for _, token := range tokens {
+		feedCalls = append(feedCalls, &utils.ViewCall{ContractName: utils.ANIWAR_FARM, MethodName: "tokenDataFeedMapping", Args: []interface{}{token}})
+	}
+	err = utils.BatchCallViewMethods(config, feedCalls)
+	if err != nil {
+		return nil, utils.StatusError(err)
+	}
+	allowed := make([]*farm_pb.AllowedToken, 0, len(tokens))
+	for i, call := range feedCalls {
+		if call.Err != nil {
+			return nil, utils.StatusError(call.Err)
+		}
+		allowed = append(allowed, &farm_pb.AllowedToken{
+			Token:    tokens[i].Hex(),
+			DataFeed: call.Result[0].(common.Address).Hex(),
+		})
+	}
+	return &farm_pb.ListAllowedTokensResponse{Tokens: allowed}, nil
+}
+
+func (*Server) GetTokenValue(ctx context.Context, in *farm_pb.GetTokenValueRequest) (*farm_pb.GetTokenValueResponse, error) {
+	config, err := utils.GetConfig()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "GetTokenValue: Cannot get config: %v", err)
+	}
+	token, err := parseAddress("GetTokenValue", in.GetToken())
+	if err != nil {
+		return nil, err
+	}
+	config, err = utils.AtBlock(ctx, config, in.GetAt())
+	if err != nil {
+		return nil, utils.StatusError(err)
+	}
+	result, err := utils.CallViewMethods(config, utils.ANIWAR_FARM, "getTokenValue", big.NewInt(0), token)
+	if err != nil {
+		return nil, utils.StatusError(err)
+	}
+	return &farm_pb.GetTokenValueResponse{
+		Price:    result[0].(*big.Int).String(),
+		Decimals: result[1].(*big.Int).Uint64(),
+	}, nil
+}
+
+func (*Server) GetBnbValue(ctx context.Context, in *farm_pb.GetBnbValueRequest) (*farm_pb.GetTokenValueResponse, error) {
+	config, err := utils.GetConfig()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "GetBnbValue: Cannot get config: %v", err)
+	}
+	config, err = utils.AtBlock(ctx, config, in.GetAt())
+	if err != nil {
+		return nil, utils.StatusError(err)
+	}
+	result, err := utils.CallViewMethods(config, utils.ANIWAR_FARM, "getBnbValue", big.NewInt(0))
+	if err != nil {
+		return nil, utils.StatusError(err)
+	}
+	return &farm_pb.GetTokenValueResponse{
+		Price:    result[0].(*big.Int).String(),
+		Decimals: result[1].(*big.Int).Uint64(),
+	}, nil
+}
+
+func (*Server) IsTokenAllowed(ctx context.Context, in *farm_pb.IsTokenAllowedRequest) (*farm_pb.IsTokenAllowedResponse, error) {
+	config, err := utils.GetConfig()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "IsTokenAllowed: Cannot get config: %v", err)
+	}
+	token, err := parseAddress("IsTokenAllowed", in.GetToken())
+	if err != nil {
+		return nil, err
+	}
+	config, err = utils.AtBlock(ctx, config, in.GetAt())
+	if err != nil {
+		return nil, utils.StatusError(err)
+	}
+	result, err := utils.CallViewMethods(config, utils.ANIWAR_FARM, "tokenIsAllowed", big.NewInt(0), token)
+	if err != nil {
+		return nil, utils.StatusError(err)
+	}
+	return &farm_pb.IsTokenAllowedResponse{Allowed: result[0].(bool)}, nil
+}
+
+func (*Server) AddAllowedToken(ctx context.Context, in *farm_pb.AddAllowedTokenRequest) (*farm_pb.FarmTxResponse, error) {
+	config, err := utils.GetConfig()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "AddAllowedToken: Cannot get config: %v", err)
+	}
+	token, err := parseAddress("AddAllowedToken", in.GetToken())
+	if err != nil {
+		return nil, err
+	}
+	// addAllowedTokens pushes duplicates, which issueTokens would then pay twice
+	result, err := utils.CallViewMethods(config, utils.ANIWAR_FARM, "tokenIsAllowed", big.NewInt(0), token)
+	if err != nil {
+		return nil, utils.StatusError(err)
+	}
+	if result[0].(bool) {
+		return nil, status.Errorf(codes.AlreadyExists, "AddAllowedToken: %s is already allowed", token.Hex())
+	}
+	return sendFarmMethod(config, "addAllowedTokens", token)
+}
+
+func (*Server) SetApy(ctx context.Context, in *farm_pb.SetApyRequest) (*farm_pb.FarmTxResponse, error) {
+	config, err := utils.GetConfig()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "SetApy: Cannot get config: %v", err)
+	}
+	apy, ok := new(big.Int).SetString(in.GetApy(), 10)
+	if !ok || apy.Sign() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "SetApy: Invalid apy %q", in.GetApy())
+	}
+	return sendFarmMethod(config, "setApy", apy)
+}
+
+func (*Server) SetDataFeedContract(ctx context.Context, in *farm_pb.SetDataFeedContractRequest) (*farm_pb.FarmTxResponse, error) {
+	config, err := utils.GetConfig()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "SetDataFeedContract: Cannot get config: %v", err)
+	}
+	token, err := parseAddress("SetDataFeedContract", in.GetToken())
+	if err != nil {
+		return nil, err
+	}
+	dataFeed, err := parseAddress("SetDataFeedContract", in.GetDataFeed())
+	if err != nil {
+		return nil, err
+	}
+	return sendFarmMethod(config, "setDataFeedContract", token, dataFeed)
+}
+
+func (*Server) IssueTokens(ctx context.Context, in *farm_pb.IssueTokensRequest) (*farm_pb.FarmTxResponse, error) {
+	config, err := utils.GetConfig()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "IssueTokens: Cannot get config: %v", err)
+	}
+	return sendFarmMethod(config, "issueTokens")
+}
+
+// sendFarmMethod sends an AniwarFarm method without waiting for it to be mined
+func sendFarmMethod(config utils.Config, methodName string, args ...interface{}) (*farm_pb.FarmTxResponse, error) {
+	tx, err := utils.CallMethods(config, utils.ANIWAR_FARM, methodName, big.NewInt(0), args...)
+	if err != nil {
+		return nil, utils.StatusError(err)
+	}
+	return &farm_pb.FarmTxResponse{TxHash: tx.Hash().Hex()}, nil
+}
+
+// allowedTokens reads the allowedTokens array of AniwarFarm
+func allowedTokens(config utils.Config) ([]common.Address, error) {
+	var tokens []common.Address
+	for len(tokens) < MAX_ALLOWED_TOKENS {
+		var calls []*utils.ViewCall
+		for i := 0; i < ALLOWED_TOKENS_BATCH; i++ {
+			calls = append(calls, &utils.ViewCall{ContractName: utils.ANIWAR_FARM, MethodName: "allowedTokens", Args: []interface{}{big.NewInt(int64(len(tokens) + i))}})
+		}
+		err := utils.BatchCallViewMethods(config, calls)
+		if err != nil {
+			return nil, err
+		}
+		for _, call := range calls {
+			var revertErr *utils.RevertError
+			if errors.As(call.Err, &revertErr) {
+				// Past the end of the array
+				return tokens, nil
+			}
+			if call.Err != nil {
+				return nil, call.Err
+			}
+			tokens = append(tokens, call.Result[0].(common.Address))
+		}
+	}
+	return tokens, nil
+}
+
+// isNothingStaked reports the "No tokens staked!" revert of getUserTotalValue
+func isNothingStaked(err error) bool {
+	var revertErr *utils.RevertError
+	return errors.As(err, &revertErr) && strings.Contains(revertErr.Reason, "No tokens staked")
+}
+
+func parseAddress(rpcName string, address string) (common.Address, error) {
+	if !common.IsHexAddress(address) {
+		return common.Address{}, status.Errorf(codes.InvalidArgument, "%s: Invalid address %q", rpcName, address)
+	}
+	return common.HexToAddress(address), nil
+}
@@ -0,0 +1,63 @@
+package farm
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/mineloop99/new-token/back_end/features/farm/farm_pb"
+	"github.com/mineloop99/new-token/back_end/utils"
+	"github.com/mineloop99/new-token/back_end/utils/configtest"
+	"github.com/mineloop99/new-token/back_end/utils/utilstest"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestFarmAdminMethods(t *testing.T) {
+	chain := utilstest.NewChain(t)
+	token := configtest.Deploy(t, chain, utils.ANIWAR_TOKEN)
+	config := configtest.New(chain, token, configtest.Deploy(t, chain, utils.ANIWAR_FARM, token.Address, big.NewInt(100)))
+	configtest.Use(t, config)
+	server := &Server{}
+	ctx := context.Background()
+
+	if _, err := server.SetApy(ctx, &farm_pb.SetApyRequest{Apy: "150"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := server.AddAllowedToken(ctx, &farm_pb.AddAllowedTokenRequest{Token: token.Address.Hex()}); err != nil {
+		t.Fatal(err)
+	}
+	chain.Commit()
+
+	result, err := utils.CallViewMethods(config, utils.ANIWAR_FARM, "apy", big.NewInt(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if apy := result[0].(*big.Int); apy.Int64() != 150 {
+		t.Errorf("apy = %v, want 150", apy)
+	}
+	allowed, err := server.IsTokenAllowed(ctx, &farm_pb.IsTokenAllowedRequest{Token: token.Address.Hex()})
+	if err != nil || !allowed.GetAllowed() {
+		t.Fatalf("allowed %v, err %v", allowed, err)
+	}
+	tokens, err := server.ListAllowedTokens(ctx, &farm_pb.ListAllowedTokensRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tokens.GetTokens()) != 1 || tokens.GetTokens()[0].GetToken() != token.Address.Hex() || tokens.GetTokens()[0].GetDataFeed() != (common.Address{}).Hex() {
+		t.Fatalf("allowed tokens = %v", tokens.GetTokens())
+	}
+	if _, err := server.AddAllowedToken(ctx, &farm_pb.AddAllowedTokenRequest{Token: token.Address.Hex()}); status.Code(err) != codes.AlreadyExists {
+		t.Fatalf("allowing the token again: err %v", err)
+	}
+
+	// nothing staked, getUserTotalValue reverts and the staker is reported empty
+	info, err := server.GetStakerInfo(ctx, &farm_pb.GetStakerInfoRequest{Staker: "0x0000000000000000000000000000000000000007"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(info.GetPositions()) != 0 || info.GetTotalValue() != "0" || info.GetPendingReward() != "0" || info.GetRewardDebt() != "0" || info.GetBnbStakingBalance() != "0" {
+		t.Fatalf("staker info = %v", info)
+	}
+}
@@ -0,0 +1 @@
+start protoc -I . -I .. --go_out=. --go-grpc_out=. ./farm_pb/farm.proto
\ No newline at end of file
@@ -18,8 +18,12 @@ const _ = grpc.SupportPackageIsVersion7
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type NftServiceClient interface {
-	// Sends a Random Reward
+	// Returns the owner, token URI and item name of an AniwarNft token
 	GetNftOwnership(ctx context.Context, in *GetNftOwnershipRequest, opts ...grpc.CallOption) (*GetNftOwnershipResponse, error)
+	// Lists the AniwarNft tokens of an owner, page by page
+	ListTokensByOwner(ctx context.Context, in *ListTokensByOwnerRequest, opts ...grpc.CallOption) (*ListTokensByOwnerResponse, error)
+	// Mints AniwarNft items for a player from the backend signer, paying the ANI mint fee
+	MintItems(ctx context.Context, in *MintItemsRequest, opts ...grpc.CallOption) (*MintItemsResponse, error)
 }
 
 type nftServiceClient struct {
@@ -39,12 +43,34 @@ func (c *nftServiceClient) GetNftOwnership(ctx context.Context, in *GetNftOwners
 	return out, nil
 }
 
+func (c *nftServiceClient) ListTokensByOwner(ctx context.Context, in *ListTokensByOwnerRequest, opts ...grpc.CallOption) (*ListTokensByOwnerResponse, error) {
+	out := new(ListTokensByOwnerResponse)
+	err := c.cc.Invoke(ctx, "/nft_pb.NftService/ListTokensByOwner", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nftServiceClient) MintItems(ctx context.Context, in *MintItemsRequest, opts ...grpc.CallOption) (*MintItemsResponse, error) {
+	out := new(MintItemsResponse)
+	err := c.cc.Invoke(ctx, "/nft_pb.NftService/MintItems", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // NftServiceServer is the server API for NftService service.
 // All implementations must embed UnimplementedNftServiceServer
 // for forward compatibility
 type NftServiceServer interface {
-	// Sends a Random Reward
+	// Returns the owner, token URI and item name of an AniwarNft token
 	GetNftOwnership(context.Context, *GetNftOwnershipRequest) (*GetNftOwnershipResponse, error)
+	// Lists the AniwarNft tokens of an owner, page by page
+	ListTokensByOwner(context.Context, *ListTokensByOwnerRequest) (*ListTokensByOwnerResponse, error)
+	// Mints AniwarNft items for a player from the backend signer, paying the ANI mint fee
+	MintItems(context.Context, *MintItemsRequest) (*MintItemsResponse, error)
 	mustEmbedUnimplementedNftServiceServer()
 }
 
@@ -55,6 +81,12 @@ type UnimplementedNftServiceServer struct {
 func (UnimplementedNftServiceServer) GetNftOwnership(context.Context, *GetNftOwnershipRequest) (*GetNftOwnershipResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetNftOwnership not implemented")
 }
+func (UnimplementedNftServiceServer) ListTokensByOwner(context.Context, *ListTokensByOwnerRequest) (*ListTokensByOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTokensByOwner not implemented")
+}
+func (UnimplementedNftServiceServer) MintItems(context.Context, *MintItemsRequest) (*MintItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintItems not implemented")
+}
 func (UnimplementedNftServiceServer) mustEmbedUnimplementedNftServiceServer() {}
 
 // UnsafeNftServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -86,6 +118,42 @@ func _NftService_GetNftOwnership_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NftService_ListTokensByOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTokensByOwnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NftServiceServer).ListTokensByOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nft_pb.NftService/ListTokensByOwner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NftServiceServer).ListTokensByOwner(ctx, req.(*ListTokensByOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NftService_MintItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MintItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NftServiceServer).MintItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nft_pb.NftService/MintItems",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NftServiceServer).MintItems(ctx, req.(*MintItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // NftService_ServiceDesc is the grpc.ServiceDesc for NftService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -97,6 +165,14 @@ var NftService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetNftOwnership",
 			Handler:    _NftService_GetNftOwnership_Handler,
 		},
+		{
+			MethodName: "ListTokensByOwner",
+			Handler:    _NftService_ListTokensByOwner_Handler,
+		},
+		{
+			MethodName: "MintItems",
+			Handler:    _NftService_MintItems_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "nft_pb/nft.proto",
@@ -2,13 +2,28 @@ package nft
 
 import (
 	"context"
-	"log"
+	"errors"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/mineloop99/new-token/back_end/features/nft/nft_pb"
 	"github.com/mineloop99/new-token/back_end/utils"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
+const DEFAULT_PAGE_SIZE = 20
+const MAX_PAGE_SIZE = 100
+
+// createManyAniwarItem accepts at most 10 names
+const MAX_MINT_ITEMS = 10
+const MINT_TIMEOUT = time.Minute * 2
+
 type Server struct {
 	nft_pb.UnimplementedNftServiceServer
 }
@@ -18,14 +33,278 @@ func RewardRegister(s grpc.ServiceRegistrar) {
 }
 
 func (*Server) GetNftOwnership(ctx context.Context, in *nft_pb.GetNftOwnershipRequest) (*nft_pb.GetNftOwnershipResponse, error) {
-	_, err := utils.GetConfig()
+	config, err := utils.GetConfig()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "GetNftOwnership: Cannot get config: %v", err)
+	}
+	tokenId, ok := new(big.Int).SetString(in.GetTokenId(), 10)
+	if !ok || tokenId.Sign() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "GetNftOwnership: Invalid token id %q", in.GetTokenId())
+	}
+	config, err = utils.AtBlock(ctx, config, in.GetAt())
+	if err != nil {
+		return nil, utils.StatusError(err)
+	}
+
+	result, err := utils.CallViewMethods(config, utils.ANIWAR_NFT, "ownerOf", big.NewInt(0), tokenId)
+	if err != nil {
+		return nil, nftStatusError(err)
+	}
+	owner := result[0].(common.Address)
+
+	result, err = utils.CallViewMethods(config, utils.ANIWAR_NFT, "tokenURI", big.NewInt(0), tokenId)
 	if err != nil {
-		log.Fatalf("GetNftOwnerShip: Cannot get config: %v", err)
+		return nil, nftStatusError(err)
 	}
-	tokenId := in.GetTokenId()
+	tokenURI := result[0].(string)
+
+	result, err = utils.CallViewMethods(config, utils.ANIWAR_NFT, "aniwarItems", big.NewInt(0), tokenId)
+	if err != nil {
+		return nil, nftStatusError(err)
+	}
+	itemName := result[1].(string)
 
-	res := "Random Number is: " + tokenId
 	return &nft_pb.GetNftOwnershipResponse{
-		Owner: res,
+		Owner:    owner.Hex(),
+		TokenUri: tokenURI,
+		ItemName: itemName,
 	}, nil
 }
+
+func (*Server) ListTokensByOwner(ctx context.Context, in *nft_pb.ListTokensByOwnerRequest) (*nft_pb.ListTokensByOwnerResponse, error) {
+	config, err := utils.GetConfig()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "ListTokensByOwner: Cannot get config: %v", err)
+	}
+	if !common.IsHexAddress(in.GetOwner()) {
+		return nil, status.Errorf(codes.InvalidArgument, "ListTokensByOwner: Invalid address %q", in.GetOwner())
+	}
+	owner := common.HexToAddress(in.GetOwner())
+	pageSize := uint64(in.GetPageSize())
+	if pageSize == 0 {
+		pageSize = DEFAULT_PAGE_SIZE
+	}
+	if pageSize > MAX_PAGE_SIZE {
+		pageSize = MAX_PAGE_SIZE
+	}
+	offset := uint64(0)
+	if in.GetPageToken() != "" {
+		offset, err = strconv.ParseUint(in.GetPageToken(), 10, 64)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "ListTokensByOwner: Invalid page token %q", in.GetPageToken())
+		}
+	}
+	config, err = utils.AtBlock(ctx, config, in.GetAt())
+	if err != nil {
+		return nil, utils.StatusError(err)
+	}
+
+	result, err := utils.CallViewMethods(config, utils.ANIWAR_NFT, "balanceOf", big.NewInt(0), owner)
+	if err != nil {
+		return nil, utils.StatusError(err)
+	}
+	total := result[0].(*big.Int).Uint64()
+	if offset >= total {
+		return &nft_pb.ListTokensByOwnerResponse{TotalCount: total}, nil
+	}
+	end := offset + pageSize
+	if end > total {
+		end = total
+	}
+
+	// One batch for the token ids, one for their names and URIs
+	var indexCalls []*utils.ViewCall
+	for i := offset; i < end; i++ {
+		indexCalls = append(indexCalls, &utils.ViewCall{
+			ContractName: utils.ANIWAR_NFT,
+			MethodName:   "tokenOfOwnerByIndex",
+			Args:         []interface{}{owner, new(big.Int).SetUint64(i)},
+		})
+	}
+	err = utils.BatchCallViewMethods(config, indexCalls)
+	if err != nil {
+		return nil, utils.StatusError(err)
+	}
+	var itemCalls []*utils.ViewCall
+	for _, call := range indexCalls {
+		if call.Err != nil {
+			return nil, utils.StatusError(call.Err)
+		}
+		tokenId := call.Result[0].(*big.Int)
+		itemCalls = append(itemCalls,
+			&utils.ViewCall{ContractName: utils.ANIWAR_NFT, MethodName: "aniwarItems", Args: []interface{}{tokenId}},
+			&utils.ViewCall{ContractName: utils.ANIWAR_NFT, MethodName: "tokenURI", Args: []interface{}{tokenId}},
+		)
+	}
+	err = utils.BatchCallViewMethods(config, itemCalls)
+	if err != nil {
+		return nil, utils.StatusError(err)
+	}
+
+	items := make([]*nft_pb.NftItem, 0, len(indexCalls))
+	for i, call := range indexCalls {
+		itemCall, uriCall := itemCalls[2*i], itemCalls[2*i+1]
+		if itemCall.Err != nil {
+			return nil, nftStatusError(itemCall.Err)
+		}
+		if uriCall.Err != nil {
+			return nil, nftStatusError(uriCall.Err)
+		}
+		items = append(items, &nft_pb.NftItem{
+			TokenId:  call.Result[0].(*big.Int).String(),
+			ItemName: itemCall.Result[1].(string),
+			TokenUri: uriCall.Result[0].(string),
+		})
+	}
+
+	nextPageToken := ""
+	if end < total {
+		nextPageToken = strconv.FormatUint(end, 10)
+	}
+	return &nft_pb.ListTokensByOwnerResponse{
+		Items:         items,
+		NextPageToken: nextPageToken,
+		TotalCount:    total,
+	}, nil
+}
+
+func (*Server) MintItems(ctx context.Context, in *nft_pb.MintItemsRequest) (*nft_pb.MintItemsResponse, error) {
+	config, err := utils.GetConfig()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "MintItems: Cannot get config: %v", err)
+	}
+	names := in.GetNames()
+	if len(names) == 0 || len(names) > MAX_MINT_ITEMS {
+		return nil, status.Errorf(codes.InvalidArgument, "MintItems: Between 1 and %d names are required, got %d", MAX_MINT_ITEMS, len(names))
+	}
+	for i, name := range names {
+		if name == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "MintItems: Name %d is empty", i)
+		}
+	}
+	if !common.IsHexAddress(in.GetPlayer()) {
+		return nil, status.Errorf(codes.InvalidArgument, "MintItems: Invalid player address %q", in.GetPlayer())
+	}
+	player := common.HexToAddress(in.GetPlayer())
+	nftContract, err := config.GetContract(utils.ANIWAR_NFT)
+	if err != nil {
+		return nil, utils.StatusError(err)
+	}
+	signer, err := utils.SignerAddress(config, utils.ANIWAR_NFT, "createManyAniwarItem")
+	if err != nil {
+		return nil, utils.StatusError(err)
+	}
+	// createManyAniwarItem mints to msg.sender, only the same signer can send the items on
+	sender, err := utils.SignerAddress(config, utils.ANIWAR_NFT, "transferFrom")
+	if err != nil {
+		return nil, utils.StatusError(err)
+	}
+	if player != signer && sender != signer {
+		return nil, status.Errorf(codes.FailedPrecondition, "MintItems: AniwarNft.transferFrom must be sent by the minting signer %s", signer.Hex())
+	}
+
+	// The contract pulls mintFee whole ANI per item from the signer, mintFee * 10^decimals in the smallest unit
+	result, err := utils.CallViewMethods(config, utils.ANIWAR_NFT, "mintFee", big.NewInt(0))
+	if err != nil {
+		return nil, utils.StatusError(err)
+	}
+	mintFee := result[0].(*big.Int)
+	result, err = utils.CallViewMethods(config, utils.ANIWAR_TOKEN, "decimals", big.NewInt(0))
+	if err != nil {
+		return nil, utils.StatusError(err)
+	}
+	totalFee := mintFeeOf(len(names), mintFee, result[0].(uint8))
+	result, err = utils.CallViewMethods(config, utils.ANIWAR_TOKEN, "balanceOf", big.NewInt(0), signer)
+	if err != nil {
+		return nil, utils.StatusError(err)
+	}
+	if balance := result[0].(*big.Int); balance.Cmp(totalFee) < 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "MintItems: Signer balance %v is lower than the mint fee %v", balance, totalFee)
+	}
+	result, err = utils.CallViewMethods(config, utils.ANIWAR_TOKEN, "allowance", big.NewInt(0), signer, nftContract.Address)
+	if err != nil {
+		return nil, utils.StatusError(err)
+	}
+	if allowance := result[0].(*big.Int); allowance.Cmp(totalFee) < 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "MintItems: Signer allowance %v is lower than the mint fee %v", allowance, totalFee)
+	}
+
+	tx, err := utils.CallMethods(config, utils.ANIWAR_NFT, "createManyAniwarItem", big.NewInt(0), uint8(len(names)), names)
+	if err != nil {
+		return nil, utils.StatusError(err)
+	}
+	waitCtx, cancel := context.WithTimeout(ctx, MINT_TIMEOUT)
+	defer cancel()
+	receipt, err := utils.WaitMined(waitCtx, config, "createManyAniwarItem", tx)
+	if err != nil {
+		return nil, utils.StatusError(err)
+	}
+
+	// requestedAniwarItem(uint256 indexed requestId, address requester) is emitted once per item
+	event := nftContract.ABI.Events["requestedAniwarItem"]
+	var tokenIds []*big.Int
+	for _, log := range receipt.Logs {
+		if log.Address != nftContract.Address || len(log.Topics) < 2 || log.Topics[0] != event.ID {
+			continue
+		}
+		tokenIds = append(tokenIds, log.Topics[1].Big())
+	}
+	transferTxHashes, err := transferItems(waitCtx, config, signer, player, tokenIds)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &nft_pb.MintItemsResponse{TxHash: tx.Hash().Hex(), TransferTxHashes: transferTxHashes}
+	for _, tokenId := range tokenIds {
+		response.TokenIds = append(response.TokenIds, tokenId.String())
+	}
+	return response, nil
+}
+
+// transferItems sends the items minted by signer to player and waits for the transfers. The error
+// lists the items still held by the signer
+func transferItems(ctx context.Context, config utils.Config, signer common.Address, player common.Address, tokenIds []*big.Int) ([]string, error) {
+	if player == signer {
+		return nil, nil
+	}
+	var txs []*types.Transaction
+	for i, tokenId := range tokenIds {
+		tx, err := utils.CallMethods(config, utils.ANIWAR_NFT, "transferFrom", big.NewInt(0), signer, player, tokenId)
+		if err != nil {
+			return nil, transferError(err, tokenIds[i:])
+		}
+		txs = append(txs, tx)
+	}
+	var hashes []string
+	for i, tx := range txs {
+		if _, err := utils.WaitMined(ctx, config, "transferFrom", tx); err != nil {
+			return nil, transferError(err, tokenIds[i:])
+		}
+		hashes = append(hashes, tx.Hash().Hex())
+	}
+	return hashes, nil
+}
+
+// transferError reports a failed transfer with the minted items it may have left with the signer
+func transferError(err error, tokenIds []*big.Int) error {
+	ids := make([]string, len(tokenIds))
+	for i, tokenId := range tokenIds {
+		ids[i] = tokenId.String()
+	}
+	return status.Errorf(status.Code(utils.StatusError(err)), "MintItems: Items %s were minted but not transferred to the player: %v", strings.Join(ids, ","), err)
+}
+
+// mintFeeOf is the ANI createManyAniwarItem charges for count items: count * mintFee * 10^decimals
+func mintFeeOf(count int, mintFee *big.Int, decimals uint8) *big.Int {
+	unit := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil)
+	return new(big.Int).Mul(new(big.Int).Mul(big.NewInt(int64(count)), mintFee), unit)
+}
+
+// nftStatusError reports ERC721 "nonexistent token" reverts as NotFound
+func nftStatusError(err error) error {
+	var revertErr *utils.RevertError
+	if errors.As(err, &revertErr) && strings.Contains(revertErr.Reason, "nonexistent token") {
+		return status.Error(codes.NotFound, revertErr.Error())
+	}
+	return utils.StatusError(err)
+}
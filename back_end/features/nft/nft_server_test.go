@@ -0,0 +1,200 @@
+package nft
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/mineloop99/new-token/back_end/features/nft/nft_pb"
+	"github.com/mineloop99/new-token/back_end/utils"
+	"github.com/mineloop99/new-token/back_end/utils/configtest"
+	"github.com/mineloop99/new-token/back_end/utils/utilstest"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// newTestChain deploys AniwarToken and AniwarNft and makes them the config of the service,
+// the deployer holds the ANI supply and signs the service transactions
+func newTestChain(t *testing.T) (*utilstest.Chain, utils.Config) {
+	t.Helper()
+	chain := utilstest.NewChain(t)
+	token := configtest.Deploy(t, chain, utils.ANIWAR_TOKEN)
+	config := configtest.New(chain, token, configtest.Deploy(t, chain, utils.ANIWAR_NFT, token.Address))
+	configtest.Use(t, config)
+	return chain, config
+}
+
+// mintTestItems mints names to the deployer, paying the mint fee from its ANI
+func mintTestItems(t *testing.T, chain *utilstest.Chain, config utils.Config, names ...string) {
+	t.Helper()
+	token, nft := config.Contracts[utils.ANIWAR_TOKEN], config.Contracts[utils.ANIWAR_NFT]
+	fee := new(big.Int).Mul(big.NewInt(200*int64(len(names))), new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil))
+	chain.Transact(t, token.Address, token.ABI, "approve", nft.Address, fee)
+	chain.Transact(t, nft.Address, nft.ABI, "createManyAniwarItem", uint8(len(names)), names)
+}
+
+func TestListTokensByOwnerPages(t *testing.T) {
+	chain, config := newTestChain(t)
+	mintTestItems(t, chain, config, "Sword", "Shield", "Bow", "Axe", "Staff")
+	server := &Server{}
+	ctx := context.Background()
+
+	var names []string
+	pageToken := ""
+	for page := 0; ; page++ {
+		response, err := server.ListTokensByOwner(ctx, &nft_pb.ListTokensByOwnerRequest{Owner: chain.Deployer.Hex(), PageSize: 2, PageToken: pageToken})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if response.GetTotalCount() != 5 {
+			t.Fatalf("page %d: total count = %d, want 5", page, response.GetTotalCount())
+		}
+		for i, item := range response.GetItems() {
+			if want := big.NewInt(int64(2*page + i)).String(); item.GetTokenId() != want {
+				t.Errorf("page %d item %d: token id = %s, want %s", page, i, item.GetTokenId(), want)
+			}
+			names = append(names, item.GetItemName())
+		}
+		pageToken = response.GetNextPageToken()
+		if pageToken == "" {
+			if page != 2 || len(response.GetItems()) != 1 {
+				t.Fatalf("last page %d has %d items, want page 2 with 1 item", page, len(response.GetItems()))
+			}
+			break
+		}
+		if len(response.GetItems()) != 2 {
+			t.Fatalf("page %d has %d items, want 2", page, len(response.GetItems()))
+		}
+	}
+	if got := strings.Join(names, ","); got != "Sword,Shield,Bow,Axe,Staff" {
+		t.Fatalf("names = %s", got)
+	}
+
+	// past the end the total is still reported
+	response, err := server.ListTokensByOwner(ctx, &nft_pb.ListTokensByOwnerRequest{Owner: chain.Deployer.Hex(), PageToken: "10"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(response.GetItems()) != 0 || response.GetNextPageToken() != "" || response.GetTotalCount() != 5 {
+		t.Fatalf("past the end: %v", response)
+	}
+	if _, err := server.ListTokensByOwner(ctx, &nft_pb.ListTokensByOwnerRequest{Owner: chain.Deployer.Hex(), PageToken: "two"}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("invalid page token: err %v", err)
+	}
+}
+
+// mine commits a block every few milliseconds until the test ends, for the RPCs waiting for their transaction
+func mine(t *testing.T, chain *utilstest.Chain) {
+	done := make(chan struct{})
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		ticker := time.NewTicker(time.Millisecond * 5)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				chain.Commit()
+			}
+		}
+	}()
+	t.Cleanup(func() {
+		close(done)
+		<-stopped
+	})
+}
+
+func TestMintItemsChecksTheFee(t *testing.T) {
+	chain, config := newTestChain(t)
+	token, nft := config.Contracts[utils.ANIWAR_TOKEN], config.Contracts[utils.ANIWAR_NFT]
+	server := &Server{}
+	ctx := context.Background()
+	names := []string{"Sword", "Shield"}
+	player := common.HexToAddress("0x0000000000000000000000000000000000000007")
+	request := &nft_pb.MintItemsRequest{Names: names, Player: player.Hex()}
+	// 2 items at the default mintFee of 200 ANI with 18 decimals
+	fee := new(big.Int).Mul(big.NewInt(400), new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil))
+
+	if _, err := server.MintItems(ctx, request); status.Code(err) != codes.FailedPrecondition || !strings.Contains(err.Error(), "allowance") {
+		t.Fatalf("without allowance: err %v", err)
+	}
+	chain.Transact(t, token.Address, token.ABI, "approve", nft.Address, new(big.Int).Sub(fee, big.NewInt(1)))
+	if _, err := server.MintItems(ctx, request); status.Code(err) != codes.FailedPrecondition || !strings.Contains(err.Error(), "allowance") {
+		t.Fatalf("allowance one below the fee: err %v", err)
+	}
+
+	chain.Transact(t, token.Address, token.ABI, "approve", nft.Address, fee)
+	mine(t, chain)
+	response, err := server.MintItems(ctx, request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.Join(response.GetTokenIds(), ","); got != "0,1" {
+		t.Fatalf("token ids = %s, want 0,1", got)
+	}
+	if len(response.GetTransferTxHashes()) != 2 {
+		t.Fatalf("transfer txs = %v, want 2", response.GetTransferTxHashes())
+	}
+	for _, tokenId := range []int64{0, 1} {
+		result, err := utils.CallViewMethods(config, utils.ANIWAR_NFT, "ownerOf", big.NewInt(0), big.NewInt(tokenId))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if owner := result[0].(common.Address); owner != player {
+			t.Errorf("item %d is owned by %s, want the player", tokenId, owner.Hex())
+		}
+	}
+	result, err := utils.CallViewMethods(config, utils.ANIWAR_TOKEN, "balanceOf", big.NewInt(0), nft.Address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if paid := result[0].(*big.Int); paid.Cmp(fee) != 0 {
+		t.Errorf("AniwarNft received %v ANI, want %v", paid, fee)
+	}
+
+	// the allowance is enough again but the signer gave its ANI away
+	result, err = utils.CallViewMethods(config, utils.ANIWAR_TOKEN, "balanceOf", big.NewInt(0), chain.Deployer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	chain.Transact(t, token.Address, token.ABI, "approve", nft.Address, fee)
+	chain.Transact(t, token.Address, token.ABI, "transfer", nft.Address, new(big.Int).Sub(result[0].(*big.Int), big.NewInt(1)))
+	if _, err := server.MintItems(ctx, request); status.Code(err) != codes.FailedPrecondition || !strings.Contains(err.Error(), "balance") {
+		t.Fatalf("without balance: err %v", err)
+	}
+}
+
+func TestMintItemsRequiresAPlayer(t *testing.T) {
+	newTestChain(t)
+	for _, player := range []string{"", "0x1234"} {
+		_, err := (&Server{}).MintItems(context.Background(), &nft_pb.MintItemsRequest{Names: []string{"Sword"}, Player: player})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Fatalf("player %q: err %v", player, err)
+		}
+	}
+}
+
+func TestMintFeeOf(t *testing.T) {
+	fourHundredAni, _ := new(big.Int).SetString("400000000000000000000", 10)
+	tests := []struct {
+		count    int
+		mintFee  int64
+		decimals uint8
+		want     *big.Int
+	}{
+		{2, 200, 18, fourHundredAni},
+		{1, 0, 18, big.NewInt(0)},
+		{10, 200, 0, big.NewInt(2000)},
+		{3, 5, 2, big.NewInt(1500)},
+	}
+	for _, test := range tests {
+		if got := mintFeeOf(test.count, big.NewInt(test.mintFee), test.decimals); got.Cmp(test.want) != 0 {
+			t.Errorf("%d items at %d with %d decimals: got %v, want %v", test.count, test.mintFee, test.decimals, got, test.want)
+		}
+	}
+}
@@ -0,0 +1 @@
+start protoc -I . -I .. --go_out=. --go-grpc_out=. ./pool_pb/pool.proto
\ No newline at end of file
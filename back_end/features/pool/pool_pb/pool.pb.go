@@ -0,0 +1,834 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        v3.19.1
+// source: pool_pb/pool.proto
+
+package pool_pb
+
+import (
+	block_pb "github.com/mineloop99/new-token/back_end/features/block/block_pb"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type StakingEventType int32
+
+const (
+	StakingEventType_UNKNOWN            StakingEventType = 0
+	StakingEventType_ENTER_STAKING      StakingEventType = 1
+	StakingEventType_LEAVE_STAKING      StakingEventType = 2
+	StakingEventType_CLAIM_REWARD       StakingEventType = 3
+	StakingEventType_EMERGENCY_WITHDRAW StakingEventType = 4
+)
+
+// Enum value maps for StakingEventType.
+var (
+	StakingEventType_name = map[int32]string{
+		0: "UNKNOWN",
+		1: "ENTER_STAKING",
+		2: "LEAVE_STAKING",
+		3: "CLAIM_REWARD",
+		4: "EMERGENCY_WITHDRAW",
+	}
+	StakingEventType_value = map[string]int32{
+		"UNKNOWN":            0,
+		"ENTER_STAKING":      1,
+		"LEAVE_STAKING":      2,
+		"CLAIM_REWARD":       3,
+		"EMERGENCY_WITHDRAW": 4,
+	}
+)
+
+func (x StakingEventType) Enum() *StakingEventType {
+	p := new(StakingEventType)
+	*p = x
+	return p
+}
+
+func (x StakingEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StakingEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_pool_pb_pool_proto_enumTypes[0].Descriptor()
+}
+
+func (StakingEventType) Type() protoreflect.EnumType {
+	return &file_pool_pb_pool_proto_enumTypes[0]
+}
+
+func (x StakingEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StakingEventType.Descriptor instead.
+func (StakingEventType) EnumDescriptor() ([]byte, []int) {
+	return file_pool_pb_pool_proto_rawDescGZIP(), []int{0}
+}
+
+type GetPoolInfoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Latest block when unset
+	At *block_pb.AtBlock `protobuf:"bytes,1,opt,name=at,proto3" json:"at,omitempty"`
+}
+
+func (x *GetPoolInfoRequest) Reset() {
+	*x = GetPoolInfoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pool_pb_pool_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPoolInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPoolInfoRequest) ProtoMessage() {}
+
+func (x *GetPoolInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pool_pb_pool_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPoolInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetPoolInfoRequest) Descriptor() ([]byte, []int) {
+	return file_pool_pb_pool_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetPoolInfoRequest) GetAt() *block_pb.AtBlock {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+type GetPoolInfoResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StakingToken string `protobuf:"bytes,1,opt,name=staking_token,json=stakingToken,proto3" json:"staking_token,omitempty"`
+	// Yearly rate in thousandths
+	Apy string `protobuf:"bytes,2,opt,name=apy,proto3" json:"apy,omitempty"`
+	// Unix seconds
+	StartTime       uint64 `protobuf:"varint,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime         uint64 `protobuf:"varint,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	BonusMultiplier string `protobuf:"bytes,5,opt,name=bonus_multiplier,json=bonusMultiplier,proto3" json:"bonus_multiplier,omitempty"`
+	// AniwarToken balance of the pool: the staked ANI and the ANI funding the rewards. Amounts are
+	// base 10 strings in the token's smallest unit
+	TotalStaked string `protobuf:"bytes,6,opt,name=total_staked,json=totalStaked,proto3" json:"total_staked,omitempty"`
+	Paused      bool   `protobuf:"varint,7,opt,name=paused,proto3" json:"paused,omitempty"`
+}
+
+func (x *GetPoolInfoResponse) Reset() {
+	*x = GetPoolInfoResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pool_pb_pool_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPoolInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPoolInfoResponse) ProtoMessage() {}
+
+func (x *GetPoolInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pool_pb_pool_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPoolInfoResponse.ProtoReflect.Descriptor instead.
+func (*GetPoolInfoResponse) Descriptor() ([]byte, []int) {
+	return file_pool_pb_pool_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetPoolInfoResponse) GetStakingToken() string {
+	if x != nil {
+		return x.StakingToken
+	}
+	return ""
+}
+
+func (x *GetPoolInfoResponse) GetApy() string {
+	if x != nil {
+		return x.Apy
+	}
+	return ""
+}
+
+func (x *GetPoolInfoResponse) GetStartTime() uint64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *GetPoolInfoResponse) GetEndTime() uint64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *GetPoolInfoResponse) GetBonusMultiplier() string {
+	if x != nil {
+		return x.BonusMultiplier
+	}
+	return ""
+}
+
+func (x *GetPoolInfoResponse) GetTotalStaked() string {
+	if x != nil {
+		return x.TotalStaked
+	}
+	return ""
+}
+
+func (x *GetPoolInfoResponse) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+type GetUserInfoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User string `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	// Latest block when unset
+	At *block_pb.AtBlock `protobuf:"bytes,2,opt,name=at,proto3" json:"at,omitempty"`
+}
+
+func (x *GetUserInfoRequest) Reset() {
+	*x = GetUserInfoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pool_pb_pool_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserInfoRequest) ProtoMessage() {}
+
+func (x *GetUserInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pool_pb_pool_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetUserInfoRequest) Descriptor() ([]byte, []int) {
+	return file_pool_pb_pool_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetUserInfoRequest) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *GetUserInfoRequest) GetAt() *block_pb.AtBlock {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+type GetUserInfoResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User   string `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Amount string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Reward already credited by updateUser
+	RewardDebt     string `protobuf:"bytes,3,opt,name=reward_debt,json=rewardDebt,proto3" json:"reward_debt,omitempty"`
+	TimeLastStaked uint64 `protobuf:"varint,4,opt,name=time_last_staked,json=timeLastStaked,proto3" json:"time_last_staked,omitempty"`
+	// reward_debt plus the reward accrued since time_last_staked, as calculateRewardDebt computes it
+	PendingReward string `protobuf:"bytes,5,opt,name=pending_reward,json=pendingReward,proto3" json:"pending_reward,omitempty"`
+}
+
+func (x *GetUserInfoResponse) Reset() {
+	*x = GetUserInfoResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pool_pb_pool_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserInfoResponse) ProtoMessage() {}
+
+func (x *GetUserInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pool_pb_pool_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserInfoResponse.ProtoReflect.Descriptor instead.
+func (*GetUserInfoResponse) Descriptor() ([]byte, []int) {
+	return file_pool_pb_pool_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetUserInfoResponse) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *GetUserInfoResponse) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *GetUserInfoResponse) GetRewardDebt() string {
+	if x != nil {
+		return x.RewardDebt
+	}
+	return ""
+}
+
+func (x *GetUserInfoResponse) GetTimeLastStaked() uint64 {
+	if x != nil {
+		return x.TimeLastStaked
+	}
+	return 0
+}
+
+func (x *GetUserInfoResponse) GetPendingReward() string {
+	if x != nil {
+		return x.PendingReward
+	}
+	return ""
+}
+
+type ListStakingHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User string `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	// Defaults to 20, at most 100
+	PageSize uint32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// next_page_token of the previous response, empty for the first page
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Latest block when unset
+	At *block_pb.AtBlock `protobuf:"bytes,4,opt,name=at,proto3" json:"at,omitempty"`
+}
+
+func (x *ListStakingHistoryRequest) Reset() {
+	*x = ListStakingHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pool_pb_pool_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListStakingHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStakingHistoryRequest) ProtoMessage() {}
+
+func (x *ListStakingHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pool_pb_pool_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStakingHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ListStakingHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_pool_pb_pool_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListStakingHistoryRequest) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *ListStakingHistoryRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListStakingHistoryRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListStakingHistoryRequest) GetAt() *block_pb.AtBlock {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+type StakingEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type        StakingEventType `protobuf:"varint,1,opt,name=type,proto3,enum=pool_pb.StakingEventType" json:"type,omitempty"`
+	Amount      string           `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	BlockNumber uint64           `protobuf:"varint,3,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// Unix seconds of the block
+	BlockTime uint64 `protobuf:"varint,4,opt,name=block_time,json=blockTime,proto3" json:"block_time,omitempty"`
+	TxHash    string `protobuf:"bytes,5,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	LogIndex  uint32 `protobuf:"varint,6,opt,name=log_index,json=logIndex,proto3" json:"log_index,omitempty"`
+}
+
+func (x *StakingEvent) Reset() {
+	*x = StakingEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pool_pb_pool_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StakingEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StakingEvent) ProtoMessage() {}
+
+func (x *StakingEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_pool_pb_pool_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StakingEvent.ProtoReflect.Descriptor instead.
+func (*StakingEvent) Descriptor() ([]byte, []int) {
+	return file_pool_pb_pool_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *StakingEvent) GetType() StakingEventType {
+	if x != nil {
+		return x.Type
+	}
+	return StakingEventType_UNKNOWN
+}
+
+func (x *StakingEvent) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *StakingEvent) GetBlockNumber() uint64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+func (x *StakingEvent) GetBlockTime() uint64 {
+	if x != nil {
+		return x.BlockTime
+	}
+	return 0
+}
+
+func (x *StakingEvent) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+func (x *StakingEvent) GetLogIndex() uint32 {
+	if x != nil {
+		return x.LogIndex
+	}
+	return 0
+}
+
+type ListStakingHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Events []*StakingEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	// <block>:<log index> of the last event, the next page has the older ones. Empty when there are no
+	// more events
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListStakingHistoryResponse) Reset() {
+	*x = ListStakingHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pool_pb_pool_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListStakingHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStakingHistoryResponse) ProtoMessage() {}
+
+func (x *ListStakingHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pool_pb_pool_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStakingHistoryResponse.ProtoReflect.Descriptor instead.
+func (*ListStakingHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_pool_pb_pool_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListStakingHistoryResponse) GetEvents() []*StakingEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *ListStakingHistoryResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+var File_pool_pb_pool_proto protoreflect.FileDescriptor
+
+var file_pool_pb_pool_proto_rawDesc = []byte{
+	0x0a, 0x12, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x70, 0x62, 0x2f, 0x70, 0x6f, 0x6f, 0x6c, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x70, 0x62, 0x1a, 0x1a, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x2f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x70, 0x62, 0x2f, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x37, 0x0a, 0x12, 0x47, 0x65, 0x74,
+	0x50, 0x6f, 0x6f, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x21, 0x0a, 0x02, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x5f, 0x70, 0x62, 0x2e, 0x41, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x02,
+	0x61, 0x74, 0x22, 0xec, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x74,
+	0x61, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x10, 0x0a, 0x03, 0x61, 0x70, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x61, 0x70,
+	0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x62,
+	0x6f, 0x6e, 0x75, 0x73, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x62, 0x6f, 0x6e, 0x75, 0x73, 0x4d, 0x75, 0x6c, 0x74,
+	0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
+	0x73, 0x74, 0x61, 0x6b, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x75,
+	0x73, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65,
+	0x64, 0x22, 0x4b, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x02, 0x61,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
+	0x70, 0x62, 0x2e, 0x41, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x02, 0x61, 0x74, 0x22, 0xb3,
+	0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x64, 0x65, 0x62,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x44,
+	0x65, 0x62, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6c, 0x61, 0x73, 0x74,
+	0x5f, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x74,
+	0x69, 0x6d, 0x65, 0x4c, 0x61, 0x73, 0x74, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x64, 0x12, 0x25, 0x0a,
+	0x0e, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x22, 0x8e, 0x01, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61,
+	0x6b, 0x69, 0x6e, 0x67, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53,
+	0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x21, 0x0a, 0x02, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x70, 0x62, 0x2e, 0x41, 0x74, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x52, 0x02, 0x61, 0x74, 0x22, 0xcd, 0x01, 0x0a, 0x0c, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e,
+	0x67, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x2d, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x70, 0x62, 0x2e, 0x53,
+	0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a,
+	0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x17, 0x0a, 0x07, 0x74, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x6f, 0x67, 0x5f,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6c, 0x6f, 0x67,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x73, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61,
+	0x6b, 0x69, 0x6e, 0x67, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x70, 0x62, 0x2e, 0x53, 0x74,
+	0x61, 0x6b, 0x69, 0x6e, 0x67, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78,
+	0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x2a, 0x6f, 0x0a, 0x10, 0x53, 0x74,
+	0x61, 0x6b, 0x69, 0x6e, 0x67, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b,
+	0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x45,
+	0x4e, 0x54, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x4b, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x11,
+	0x0a, 0x0d, 0x4c, 0x45, 0x41, 0x56, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x4b, 0x49, 0x4e, 0x47, 0x10,
+	0x02, 0x12, 0x10, 0x0a, 0x0c, 0x43, 0x4c, 0x41, 0x49, 0x4d, 0x5f, 0x52, 0x45, 0x57, 0x41, 0x52,
+	0x44, 0x10, 0x03, 0x12, 0x16, 0x0a, 0x12, 0x45, 0x4d, 0x45, 0x52, 0x47, 0x45, 0x4e, 0x43, 0x59,
+	0x5f, 0x57, 0x49, 0x54, 0x48, 0x44, 0x52, 0x41, 0x57, 0x10, 0x04, 0x32, 0x80, 0x02, 0x0a, 0x0b,
+	0x50, 0x6f, 0x6f, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x48, 0x0a, 0x0b, 0x47,
+	0x65, 0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1b, 0x2e, 0x70, 0x6f, 0x6f,
+	0x6c, 0x5f, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x70,
+	0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1b, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x70, 0x62, 0x2e, 0x47,
+	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x5d, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x22, 0x2e, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x70, 0x62, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x70, 0x6f, 0x6f, 0x6c,
+	0x5f, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x0a,
+	0x5a, 0x08, 0x2f, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_pool_pb_pool_proto_rawDescOnce sync.Once
+	file_pool_pb_pool_proto_rawDescData = file_pool_pb_pool_proto_rawDesc
+)
+
+func file_pool_pb_pool_proto_rawDescGZIP() []byte {
+	file_pool_pb_pool_proto_rawDescOnce.Do(func() {
+		file_pool_pb_pool_proto_rawDescData = protoimpl.X.CompressGZIP(file_pool_pb_pool_proto_rawDescData)
+	})
+	return file_pool_pb_pool_proto_rawDescData
+}
+
+var file_pool_pb_pool_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_pool_pb_pool_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_pool_pb_pool_proto_goTypes = []interface{}{
+	(StakingEventType)(0),              // 0: pool_pb.StakingEventType
+	(*GetPoolInfoRequest)(nil),         // 1: pool_pb.GetPoolInfoRequest
+	(*GetPoolInfoResponse)(nil),        // 2: pool_pb.GetPoolInfoResponse
+	(*GetUserInfoRequest)(nil),         // 3: pool_pb.GetUserInfoRequest
+	(*GetUserInfoResponse)(nil),        // 4: pool_pb.GetUserInfoResponse
+	(*ListStakingHistoryRequest)(nil),  // 5: pool_pb.ListStakingHistoryRequest
+	(*StakingEvent)(nil),               // 6: pool_pb.StakingEvent
+	(*ListStakingHistoryResponse)(nil), // 7: pool_pb.ListStakingHistoryResponse
+	(*block_pb.AtBlock)(nil),           // 8: block_pb.AtBlock
+}
+var file_pool_pb_pool_proto_depIdxs = []int32{
+	8, // 0: pool_pb.GetPoolInfoRequest.at:type_name -> block_pb.AtBlock
+	8, // 1: pool_pb.GetUserInfoRequest.at:type_name -> block_pb.AtBlock
+	8, // 2: pool_pb.ListStakingHistoryRequest.at:type_name -> block_pb.AtBlock
+	0, // 3: pool_pb.StakingEvent.type:type_name -> pool_pb.StakingEventType
+	6, // 4: pool_pb.ListStakingHistoryResponse.events:type_name -> pool_pb.StakingEvent
+	1, // 5: pool_pb.PoolService.GetPoolInfo:input_type -> pool_pb.GetPoolInfoRequest
+	3, // 6: pool_pb.PoolService.GetUserInfo:input_type -> pool_pb.GetUserInfoRequest
+	5, // 7: pool_pb.PoolService.ListStakingHistory:input_type -> pool_pb.ListStakingHistoryRequest
+	2, // 8: pool_pb.PoolService.GetPoolInfo:output_type -> pool_pb.GetPoolInfoResponse
+	4, // 9: pool_pb.PoolService.GetUserInfo:output_type -> pool_pb.GetUserInfoResponse
+	7, // 10: pool_pb.PoolService.ListStakingHistory:output_type -> pool_pb.ListStakingHistoryResponse
+	8, // [8:11] is the sub-list for method output_type
+	5, // [5:8] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_pool_pb_pool_proto_init() }
+func file_pool_pb_pool_proto_init() {
+	if File_pool_pb_pool_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_pool_pb_pool_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPoolInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pool_pb_pool_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPoolInfoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pool_pb_pool_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pool_pb_pool_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserInfoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pool_pb_pool_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListStakingHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pool_pb_pool_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StakingEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pool_pb_pool_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListStakingHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_pool_pb_pool_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_pool_pb_pool_proto_goTypes,
+		DependencyIndexes: file_pool_pb_pool_proto_depIdxs,
+		EnumInfos:         file_pool_pb_pool_proto_enumTypes,
+		MessageInfos:      file_pool_pb_pool_proto_msgTypes,
+	}.Build()
+	File_pool_pb_pool_proto = out.File
+	file_pool_pb_pool_proto_rawDesc = nil
+	file_pool_pb_pool_proto_goTypes = nil
+	file_pool_pb_pool_proto_depIdxs = nil
+}
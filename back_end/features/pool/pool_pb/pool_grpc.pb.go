@@ -0,0 +1,179 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package pool_pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// PoolServiceClient is the client API for PoolService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PoolServiceClient interface {
+	// Returns the AniwarPool settings and the amount staked in it
+	GetPoolInfo(ctx context.Context, in *GetPoolInfoRequest, opts ...grpc.CallOption) (*GetPoolInfoResponse, error)
+	// Returns the stake of a user and the reward it would claim now
+	GetUserInfo(ctx context.Context, in *GetUserInfoRequest, opts ...grpc.CallOption) (*GetUserInfoResponse, error)
+	// Lists the staking events of a user, newest first
+	ListStakingHistory(ctx context.Context, in *ListStakingHistoryRequest, opts ...grpc.CallOption) (*ListStakingHistoryResponse, error)
+}
+
+type poolServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPoolServiceClient(cc grpc.ClientConnInterface) PoolServiceClient {
+	return &poolServiceClient{cc}
+}
+
+func (c *poolServiceClient) GetPoolInfo(ctx context.Context, in *GetPoolInfoRequest, opts ...grpc.CallOption) (*GetPoolInfoResponse, error) {
+	out := new(GetPoolInfoResponse)
+	err := c.cc.Invoke(ctx, "/pool_pb.PoolService/GetPoolInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *poolServiceClient) GetUserInfo(ctx context.Context, in *GetUserInfoRequest, opts ...grpc.CallOption) (*GetUserInfoResponse, error) {
+	out := new(GetUserInfoResponse)
+	err := c.cc.Invoke(ctx, "/pool_pb.PoolService/GetUserInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *poolServiceClient) ListStakingHistory(ctx context.Context, in *ListStakingHistoryRequest, opts ...grpc.CallOption) (*ListStakingHistoryResponse, error) {
+	out := new(ListStakingHistoryResponse)
+	err := c.cc.Invoke(ctx, "/pool_pb.PoolService/ListStakingHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PoolServiceServer is the server API for PoolService service.
+// All implementations must embed UnimplementedPoolServiceServer
+// for forward compatibility
+type PoolServiceServer interface {
+	// Returns the AniwarPool settings and the amount staked in it
+	GetPoolInfo(context.Context, *GetPoolInfoRequest) (*GetPoolInfoResponse, error)
+	// Returns the stake of a user and the reward it would claim now
+	GetUserInfo(context.Context, *GetUserInfoRequest) (*GetUserInfoResponse, error)
+	// Lists the staking events of a user, newest first
+	ListStakingHistory(context.Context, *ListStakingHistoryRequest) (*ListStakingHistoryResponse, error)
+	mustEmbedUnimplementedPoolServiceServer()
+}
+
+// UnimplementedPoolServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedPoolServiceServer struct {
+}
+
+func (UnimplementedPoolServiceServer) GetPoolInfo(context.Context, *GetPoolInfoRequest) (*GetPoolInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPoolInfo not implemented")
+}
+func (UnimplementedPoolServiceServer) GetUserInfo(context.Context, *GetUserInfoRequest) (*GetUserInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserInfo not implemented")
+}
+func (UnimplementedPoolServiceServer) ListStakingHistory(context.Context, *ListStakingHistoryRequest) (*ListStakingHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStakingHistory not implemented")
+}
+func (UnimplementedPoolServiceServer) mustEmbedUnimplementedPoolServiceServer() {}
+
+// UnsafePoolServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PoolServiceServer will
+// result in compilation errors.
+type UnsafePoolServiceServer interface {
+	mustEmbedUnimplementedPoolServiceServer()
+}
+
+func RegisterPoolServiceServer(s grpc.ServiceRegistrar, srv PoolServiceServer) {
+	s.RegisterService(&PoolService_ServiceDesc, srv)
+}
+
+func _PoolService_GetPoolInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPoolInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PoolServiceServer).GetPoolInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pool_pb.PoolService/GetPoolInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PoolServiceServer).GetPoolInfo(ctx, req.(*GetPoolInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PoolService_GetUserInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PoolServiceServer).GetUserInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pool_pb.PoolService/GetUserInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PoolServiceServer).GetUserInfo(ctx, req.(*GetUserInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PoolService_ListStakingHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStakingHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PoolServiceServer).ListStakingHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pool_pb.PoolService/ListStakingHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PoolServiceServer).ListStakingHistory(ctx, req.(*ListStakingHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PoolService_ServiceDesc is the grpc.ServiceDesc for PoolService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PoolService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pool_pb.PoolService",
+	HandlerType: (*PoolServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetPoolInfo",
+			Handler:    _PoolService_GetPoolInfo_Handler,
+		},
+		{
+			MethodName: "GetUserInfo",
+			Handler:    _PoolService_GetUserInfo_Handler,
+		},
+		{
+			MethodName: "ListStakingHistory",
+			Handler:    _PoolService_ListStakingHistory_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pool_pb/pool.proto",
+}
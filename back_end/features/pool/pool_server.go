@@ -0,0 +1,315 @@
+package pool
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/mineloop99/new-token/back_end/features/pool/pool_pb"
+	"github.com/mineloop99/new-token/back_end/utils"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const DEFAULT_PAGE_SIZE = 20
+const MAX_PAGE_SIZE = 100
+
+// Event scans can span many eth_getLogs requests
+const HISTORY_TIMEOUT = time.Second * 30
+
+var eventTypes = map[string]pool_pb.StakingEventType{
+	"EnterStaking":      pool_pb.StakingEventType_ENTER_STAKING,
+	"LeaveStaking":      pool_pb.StakingEventType_LEAVE_STAKING,
+	"ClaimReward":       pool_pb.StakingEventType_CLAIM_REWARD,
+	"EmergencyWithdraw": pool_pb.StakingEventType_EMERGENCY_WITHDRAW,
+}
+
+var stakingEvents = []string{"EnterStaking", "LeaveStaking", "ClaimReward", "EmergencyWithdraw"}
+
+type Server struct {
+	pool_pb.UnimplementedPoolServiceServer
+}
+
+func RewardRegister(s grpc.ServiceRegistrar) {
+	pool_pb.RegisterPoolServiceServer(s, &Server{})
+}
+
+type poolInfo struct {
+	stakingToken    common.Address
+	apy             *big.Int
+	startTime       *big.Int
+	endTime         *big.Int
+	bonusMultiplier *big.Int
+}
+
+func (*Server) GetPoolInfo(ctx context.Context, in *pool_pb.GetPoolInfoRequest) (*pool_pb.GetPoolInfoResponse, error) {
+	config, err := utils.GetConfig()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "GetPoolInfo: Cannot get config: %v", err)
+	}
+	config, err = utils.AtBlock(ctx, config, in.GetAt())
+	if err != nil {
+		return nil, utils.StatusError(err)
+	}
+	poolContract, err := config.GetContract(utils.ANIWAR_POOL)
+	if err != nil {
+		return nil, utils.StatusError(err)
+	}
+	tokenContract, err := config.GetContract(utils.ANIWAR_TOKEN)
+	if err != nil {
+		return nil, utils.StatusError(err)
+	}
+
+	calls := poolInfoCalls()
+	paused := &utils.ViewCall{ContractName: utils.ANIWAR_POOL, MethodName: "paused"}
+	// The pool holds the staked ANI and the ANI funding the rewards
+	balance := &utils.ViewCall{ContractName: utils.ANIWAR_TOKEN, MethodName: "balanceOf", Args: []interface{}{poolContract.Address}}
+	if err := utils.BatchCallViewMethods(config, append(calls, paused, balance)); err != nil {
+		return nil, utils.StatusError(err)
+	}
+	info, err := poolInfoOf(calls)
+	if err != nil {
+		return nil, utils.StatusError(err)
+	}
+	for _, call := range []*utils.ViewCall{paused, balance} {
+		if call.Err != nil {
+			return nil, utils.StatusError(call.Err)
+		}
+	}
+	// The constructor makes ANI the lpToken, the balance is only the staked one when it is AniwarToken
+	if info.stakingToken != tokenContract.Address {
+		return nil, status.Errorf(codes.FailedPrecondition, "GetPoolInfo: Staking token %s is not AniwarToken %s", info.stakingToken.Hex(), tokenContract.Address.Hex())
+	}
+
+	return &pool_pb.GetPoolInfoResponse{
+		StakingToken:    info.stakingToken.Hex(),
+		Apy:             info.apy.String(),
+		StartTime:       info.startTime.Uint64(),
+		EndTime:         info.endTime.Uint64(),
+		BonusMultiplier: info.bonusMultiplier.String(),
+		TotalStaked:     balance.Result[0].(*big.Int).String(),
+		Paused:          paused.Result[0].(bool),
+	}, nil
+}
+
+func (*Server) GetUserInfo(ctx context.Context, in *pool_pb.GetUserInfoRequest) (*pool_pb.GetUserInfoResponse, error) {
+	config, err := utils.GetConfig()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "GetUserInfo: Cannot get config: %v", err)
+	}
+	if !common.IsHexAddress(in.GetUser()) {
+		return nil, status.Errorf(codes.InvalidArgument, "GetUserInfo: Invalid address %q", in.GetUser())
+	}
+	user := common.HexToAddress(in.GetUser())
+	config, err = utils.AtBlock(ctx, config, in.GetAt())
+	if err != nil {
+		return nil, utils.StatusError(err)
+	}
+	// The contract reads block.timestamp, use the read block rather than the server clock. The block is
+	// pinned so the reward is computed with the apy and multiplier of the stake read
+	header, err := config.Header(ctx)
+	if err != nil {
+		return nil, utils.StatusError(err)
+	}
+	config = config.AtBlock(header.Number)
+	now := new(big.Int).SetUint64(header.Time)
+
+	calls := poolInfoCalls()
+	userCall := &utils.ViewCall{ContractName: utils.ANIWAR_POOL, MethodName: "userInfo", Args: []interface{}{user}}
+	if err := utils.BatchCallViewMethods(config, append(calls, userCall)); err != nil {
+		return nil, utils.StatusError(err)
+	}
+	info, err := poolInfoOf(calls)
+	if err != nil {
+		return nil, utils.StatusError(err)
+	}
+	if userCall.Err != nil {
+		return nil, utils.StatusError(userCall.Err)
+	}
+	timeLastStaked := userCall.Result[0].(*big.Int)
+	amount := userCall.Result[1].(*big.Int)
+	rewardDebt := userCall.Result[2].(*big.Int)
+
+	// updateUser credits nothing once the pool has ended
+	pendingReward := new(big.Int).Set(rewardDebt)
+	if now.Cmp(info.endTime) < 0 && now.Cmp(timeLastStaked) >= 0 {
+		result, err := utils.CallViewMethods(config, utils.ANIWAR_POOL, "calculateRewardDebt", big.NewInt(0), timeLastStaked, now, amount)
+		if err != nil {
+			return nil, utils.StatusError(err)
+		}
+		pendingReward.Add(pendingReward, result[0].(*big.Int))
+	}
+
+	return &pool_pb.GetUserInfoResponse{
+		User:           user.Hex(),
+		Amount:         amount.String(),
+		RewardDebt:     rewardDebt.String(),
+		TimeLastStaked: timeLastStaked.Uint64(),
+		PendingReward:  pendingReward.String(),
+	}, nil
+}
+
+func (*Server) ListStakingHistory(ctx context.Context, in *pool_pb.ListStakingHistoryRequest) (*pool_pb.ListStakingHistoryResponse, error) {
+	config, err := utils.GetConfig()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "ListStakingHistory: Cannot get config: %v", err)
+	}
+	if !common.IsHexAddress(in.GetUser()) {
+		return nil, status.Errorf(codes.InvalidArgument, "ListStakingHistory: Invalid address %q", in.GetUser())
+	}
+	user := common.HexToAddress(in.GetUser())
+	config, err = utils.AtBlock(ctx, config, in.GetAt())
+	if err != nil {
+		return nil, utils.StatusError(err)
+	}
+	pageSize := int(in.GetPageSize())
+	if pageSize == 0 {
+		pageSize = DEFAULT_PAGE_SIZE
+	}
+	if pageSize > MAX_PAGE_SIZE {
+		pageSize = MAX_PAGE_SIZE
+	}
+	poolContract, err := config.GetContract(utils.ANIWAR_POOL)
+	if err != nil {
+		return nil, utils.StatusError(err)
+	}
+
+	historyCtx, cancel := context.WithTimeout(ctx, HISTORY_TIMEOUT)
+	defer cancel()
+	// The page starts after the last event of the previous one, else at the read block
+	var before eventPosition
+	if in.GetPageToken() != "" {
+		before, err = parsePageToken(in.GetPageToken())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "ListStakingHistory: Invalid page token %q", in.GetPageToken())
+		}
+	} else {
+		header, err := config.Header(historyCtx)
+		if err != nil {
+			return nil, utils.StatusError(err)
+		}
+		before = eventPosition{blockNumber: header.Number.Uint64(), logIndex: math.MaxUint32}
+	}
+	logRange := config.LogRange
+	if logRange == 0 {
+		logRange = utils.DEFAULT_LOG_RANGE
+	}
+
+	// Scan back one log range at a time until the page and one more event are found, the extra
+	// one tells whether there is a next page
+	var events []utils.Event
+	for to := before.blockNumber; len(events) <= pageSize; to -= logRange {
+		from := poolContract.StartBlock
+		if to >= from+logRange {
+			from = to - logRange + 1
+		}
+		// Every staking event has the user as first indexed argument
+		found, err := utils.FilterEvents(historyCtx, config, utils.ANIWAR_POOL, stakingEvents, from, new(big.Int).SetUint64(to), []common.Hash{utils.AddressTopic(user)})
+		if err != nil {
+			return nil, utils.StatusError(err)
+		}
+		for i := len(found) - 1; i >= 0; i-- {
+			if positionOf(found[i]).before(before) {
+				events = append(events, found[i])
+			}
+		}
+		if from == poolContract.StartBlock {
+			break
+		}
+	}
+	nextPageToken := ""
+	if len(events) > pageSize {
+		events = events[:pageSize]
+		nextPageToken = positionOf(events[pageSize-1]).String()
+	}
+
+	blockTimes := make(map[uint64]uint64)
+	page := make([]*pool_pb.StakingEvent, 0, len(events))
+	for _, event := range events {
+		blockTime, ok := blockTimes[event.Log.BlockNumber]
+		if !ok {
+			header, err := config.Client.HeaderByNumber(historyCtx, new(big.Int).SetUint64(event.Log.BlockNumber))
+			if err != nil {
+				return nil, utils.StatusError(&utils.RpcError{Method: "eth_getBlockByNumber", Err: err})
+			}
+			blockTime = header.Time
+			blockTimes[event.Log.BlockNumber] = blockTime
+		}
+		page = append(page, &pool_pb.StakingEvent{
+			Type:        eventTypes[event.Name],
+			Amount:      event.Args["amount"].(*big.Int).String(),
+			BlockNumber: event.Log.BlockNumber,
+			BlockTime:   blockTime,
+			TxHash:      event.Log.TxHash.Hex(),
+			LogIndex:    uint32(event.Log.Index),
+		})
+	}
+	return &pool_pb.ListStakingHistoryResponse{
+		Events:        page,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// eventPosition orders the events of the chain, it is the page token of ListStakingHistory
+type eventPosition struct {
+	blockNumber uint64
+	logIndex    uint64
+}
+
+func positionOf(event utils.Event) eventPosition {
+	return eventPosition{blockNumber: event.Log.BlockNumber, logIndex: uint64(event.Log.Index)}
+}
+
+func (p eventPosition) before(other eventPosition) bool {
+	return p.blockNumber < other.blockNumber || (p.blockNumber == other.blockNumber && p.logIndex < other.logIndex)
+}
+
+func (p eventPosition) String() string {
+	return strconv.FormatUint(p.blockNumber, 10) + ":" + strconv.FormatUint(p.logIndex, 10)
+}
+
+func parsePageToken(token string) (eventPosition, error) {
+	parts := strings.Split(token, ":")
+	if len(parts) != 2 {
+		return eventPosition{}, errors.New("want <block>:<log index>")
+	}
+	blockNumber, err := strconv.ParseUint(parts[0], 10, 64)
+	if err != nil {
+		return eventPosition{}, err
+	}
+	logIndex, err := strconv.ParseUint(parts[1], 10, 32)
+	if err != nil {
+		return eventPosition{}, err
+	}
+	return eventPosition{blockNumber: blockNumber, logIndex: logIndex}, nil
+}
+
+// poolInfoCalls reads the pool settings, see poolInfoOf
+func poolInfoCalls() []*utils.ViewCall {
+	return []*utils.ViewCall{
+		{ContractName: utils.ANIWAR_POOL, MethodName: "poolInfo"},
+		{ContractName: utils.ANIWAR_POOL, MethodName: "BONUS_MULTIPLIER"},
+	}
+}
+
+func poolInfoOf(calls []*utils.ViewCall) (poolInfo, error) {
+	for _, call := range calls[:2] {
+		if call.Err != nil {
+			return poolInfo{}, call.Err
+		}
+	}
+	result := calls[0].Result
+	return poolInfo{
+		stakingToken:    result[0].(common.Address),
+		apy:             result[1].(*big.Int),
+		startTime:       result[2].(*big.Int),
+		endTime:         result[3].(*big.Int),
+		bonusMultiplier: calls[1].Result[0].(*big.Int),
+	}, nil
+}
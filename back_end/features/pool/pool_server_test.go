@@ -0,0 +1,138 @@
+package pool
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/mineloop99/new-token/back_end/features/pool/pool_pb"
+	"github.com/mineloop99/new-token/back_end/utils"
+	"github.com/mineloop99/new-token/back_end/utils/configtest"
+	"github.com/mineloop99/new-token/back_end/utils/utilstest"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// newTestPool deploys AniwarToken and an AniwarPool staking it at an apy of 100 and makes them the
+// config of the service. The deployer holds the ANI supply and stakes
+func newTestPool(t *testing.T) (*utilstest.Chain, utils.Config) {
+	t.Helper()
+	chain := utilstest.NewChain(t)
+	token := configtest.Deploy(t, chain, utils.ANIWAR_TOKEN)
+	pool := configtest.Deploy(t, chain, utils.ANIWAR_POOL, token.Address, big.NewInt(100), big.NewInt(0))
+	config := configtest.New(chain, token, pool)
+	// the history pages span several eth_getLogs ranges
+	config.LogRange = 2
+	configtest.Use(t, config)
+	return chain, config
+}
+
+func stake(t *testing.T, chain *utilstest.Chain, config utils.Config, amount int64) {
+	t.Helper()
+	token, pool := config.Contracts[utils.ANIWAR_TOKEN], config.Contracts[utils.ANIWAR_POOL]
+	chain.Transact(t, token.Address, token.ABI, "approve", pool.Address, big.NewInt(amount))
+	chain.Transact(t, pool.Address, pool.ABI, "enterStaking", big.NewInt(amount))
+}
+
+func TestGetPoolInfo(t *testing.T) {
+	chain, config := newTestPool(t)
+	token, pool := config.Contracts[utils.ANIWAR_TOKEN], config.Contracts[utils.ANIWAR_POOL]
+	stake(t, chain, config, 1000)
+	// ANI sent to fund the rewards is in the balance too
+	chain.Transact(t, token.Address, token.ABI, "transfer", pool.Address, big.NewInt(500))
+	chain.Transact(t, pool.Address, pool.ABI, "updateMultiplier", big.NewInt(3))
+
+	response, err := (&Server{}).GetPoolInfo(context.Background(), &pool_pb.GetPoolInfoRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if response.GetStakingToken() != token.Address.Hex() || response.GetApy() != "100" || response.GetBonusMultiplier() != "3" {
+		t.Fatalf("settings: %v", response)
+	}
+	if response.GetTotalStaked() != "1500" || response.GetPaused() {
+		t.Fatalf("total staked %s, paused %v, want 1500 and not paused", response.GetTotalStaked(), response.GetPaused())
+	}
+}
+
+func TestGetUserInfoPendingReward(t *testing.T) {
+	chain, config := newTestPool(t)
+	pool := config.Contracts[utils.ANIWAR_POOL]
+	stake(t, chain, config, 1_000_000)
+	if err := chain.AdjustTime(3*time.Hour*24 + time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	chain.Commit()
+	request := &pool_pb.GetUserInfoRequest{User: chain.Deployer.Hex()}
+	server := &Server{}
+
+	// 3 whole days at (100 * 1000) / 365 = 273 thousandths a day: 10^6 * 3 * 273 / 10^6
+	response, err := server.GetUserInfo(context.Background(), request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if response.GetAmount() != "1000000" || response.GetRewardDebt() != "0" || response.GetPendingReward() != "819" {
+		t.Fatalf("at apy 100: %v", response)
+	}
+	// the contract computes the reward with its current apy and multiplier
+	chain.Transact(t, pool.Address, pool.ABI, "setApy", big.NewInt(365))
+	if response, err = server.GetUserInfo(context.Background(), request); err != nil || response.GetPendingReward() != "3000" {
+		t.Fatalf("at apy 365: %v, err %v", response, err)
+	}
+	chain.Transact(t, pool.Address, pool.ABI, "updateMultiplier", big.NewInt(2))
+	if response, err = server.GetUserInfo(context.Background(), request); err != nil || response.GetPendingReward() != "6000" {
+		t.Fatalf("with multiplier 2: %v, err %v", response, err)
+	}
+}
+
+func TestListStakingHistoryPages(t *testing.T) {
+	chain, config := newTestPool(t)
+	for amount := int64(1); amount <= 5; amount++ {
+		stake(t, chain, config, amount)
+		// empty blocks between the events
+		chain.Commit()
+		chain.Commit()
+	}
+	server := &Server{}
+	ctx := context.Background()
+
+	var amounts []string
+	pageToken := ""
+	for page := 0; ; page++ {
+		response, err := server.ListStakingHistory(ctx, &pool_pb.ListStakingHistoryRequest{User: chain.Deployer.Hex(), PageSize: 2, PageToken: pageToken})
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, event := range response.GetEvents() {
+			if event.GetType() != pool_pb.StakingEventType_ENTER_STAKING || event.GetBlockTime() == 0 {
+				t.Errorf("page %d: event %v", page, event)
+			}
+			amounts = append(amounts, event.GetAmount())
+		}
+		pageToken = response.GetNextPageToken()
+		if pageToken == "" {
+			if page != 2 || len(response.GetEvents()) != 1 {
+				t.Fatalf("last page %d has %d events, want page 2 with 1 event", page, len(response.GetEvents()))
+			}
+			break
+		}
+		if len(response.GetEvents()) != 2 {
+			t.Fatalf("page %d has %d events, want 2", page, len(response.GetEvents()))
+		}
+	}
+	if got := len(amounts); got != 5 || amounts[0] != "5" || amounts[4] != "1" {
+		t.Fatalf("amounts = %v, want 5 to 1", amounts)
+	}
+
+	// a page holding the last events has no next page
+	response, err := server.ListStakingHistory(ctx, &pool_pb.ListStakingHistoryRequest{User: chain.Deployer.Hex(), PageSize: 5})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(response.GetEvents()) != 5 || response.GetNextPageToken() != "" {
+		t.Fatalf("one page: %d events, next page %q", len(response.GetEvents()), response.GetNextPageToken())
+	}
+	if _, err := server.ListStakingHistory(ctx, &pool_pb.ListStakingHistoryRequest{User: chain.Deployer.Hex(), PageToken: "12"}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("invalid page token: err %v", err)
+	}
+}
@@ -18,8 +18,27 @@ const _ = grpc.SupportPackageIsVersion7
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type RewardServiceClient interface {
-	// Sends a Random Reward
+	// Deprecated: Do not use.
+	// Sends a Random Reward. Kept for the old clients, it is a Draw whose number is the message
 	GetRewardByRandom(ctx context.Context, in *GetRewardByRandomRequest, opts ...grpc.CallOption) (*GetRewardByRandomResponse, error)
+	// Records a reward from a rule of config.yaml and queues its payout from the backend signer.
+	// A reward id is paid at most once: granting it again returns the stored reward, or ALREADY_EXISTS
+	// when the player, rule or quantity differ. FAILED_PRECONDITION when rewards are disabled
+	GrantReward(ctx context.Context, in *GrantRewardRequest, opts ...grpc.CallOption) (*GrantRewardResponse, error)
+	GetRewardStatus(ctx context.Context, in *GetRewardStatusRequest, opts ...grpc.CallOption) (*RewardRecord, error)
+	// Lists the rewards in grant order
+	ListRewards(ctx context.Context, in *ListRewardsRequest, opts ...grpc.CallOption) (*ListRewardsResponse, error)
+	// Returns a draw round. The seed hash of the open round is published before its draws, the seed once
+	// the round is closed
+	GetDrawRound(ctx context.Context, in *GetDrawRoundRequest, opts ...grpc.CallOption) (*DrawRound, error)
+	// Picks one of the weighted outcomes for a request id from keccak256(seed || request_id), in the open
+	// round. A request id is drawn once: drawing it again returns the stored draw, or ALREADY_EXISTS when
+	// the weights differ. FAILED_PRECONDITION when rewards are disabled
+	Draw(ctx context.Context, in *DrawRequest, opts ...grpc.CallOption) (*DrawResponse, error)
+	// Returns a draw, with the seed of its round once revealed
+	GetDraw(ctx context.Context, in *GetDrawRequest, opts ...grpc.CallOption) (*DrawResult, error)
+	// Recomputes a draw from the revealed seed, without the server state
+	VerifyDraw(ctx context.Context, in *VerifyDrawRequest, opts ...grpc.CallOption) (*VerifyDrawResponse, error)
 }
 
 type rewardServiceClient struct {
@@ -30,6 +49,7 @@ func NewRewardServiceClient(cc grpc.ClientConnInterface) RewardServiceClient {
 	return &rewardServiceClient{cc}
 }
 
+// Deprecated: Do not use.
 func (c *rewardServiceClient) GetRewardByRandom(ctx context.Context, in *GetRewardByRandomRequest, opts ...grpc.CallOption) (*GetRewardByRandomResponse, error) {
 	out := new(GetRewardByRandomResponse)
 	err := c.cc.Invoke(ctx, "/reward_pb.RewardService/GetRewardByRandom", in, out, opts...)
@@ -39,12 +59,94 @@ func (c *rewardServiceClient) GetRewardByRandom(ctx context.Context, in *GetRewa
 	return out, nil
 }
 
+func (c *rewardServiceClient) GrantReward(ctx context.Context, in *GrantRewardRequest, opts ...grpc.CallOption) (*GrantRewardResponse, error) {
+	out := new(GrantRewardResponse)
+	err := c.cc.Invoke(ctx, "/reward_pb.RewardService/GrantReward", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rewardServiceClient) GetRewardStatus(ctx context.Context, in *GetRewardStatusRequest, opts ...grpc.CallOption) (*RewardRecord, error) {
+	out := new(RewardRecord)
+	err := c.cc.Invoke(ctx, "/reward_pb.RewardService/GetRewardStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rewardServiceClient) ListRewards(ctx context.Context, in *ListRewardsRequest, opts ...grpc.CallOption) (*ListRewardsResponse, error) {
+	out := new(ListRewardsResponse)
+	err := c.cc.Invoke(ctx, "/reward_pb.RewardService/ListRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rewardServiceClient) GetDrawRound(ctx context.Context, in *GetDrawRoundRequest, opts ...grpc.CallOption) (*DrawRound, error) {
+	out := new(DrawRound)
+	err := c.cc.Invoke(ctx, "/reward_pb.RewardService/GetDrawRound", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rewardServiceClient) Draw(ctx context.Context, in *DrawRequest, opts ...grpc.CallOption) (*DrawResponse, error) {
+	out := new(DrawResponse)
+	err := c.cc.Invoke(ctx, "/reward_pb.RewardService/Draw", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rewardServiceClient) GetDraw(ctx context.Context, in *GetDrawRequest, opts ...grpc.CallOption) (*DrawResult, error) {
+	out := new(DrawResult)
+	err := c.cc.Invoke(ctx, "/reward_pb.RewardService/GetDraw", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rewardServiceClient) VerifyDraw(ctx context.Context, in *VerifyDrawRequest, opts ...grpc.CallOption) (*VerifyDrawResponse, error) {
+	out := new(VerifyDrawResponse)
+	err := c.cc.Invoke(ctx, "/reward_pb.RewardService/VerifyDraw", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RewardServiceServer is the server API for RewardService service.
 // All implementations must embed UnimplementedRewardServiceServer
 // for forward compatibility
 type RewardServiceServer interface {
-	// Sends a Random Reward
+	// Deprecated: Do not use.
+	// Sends a Random Reward. Kept for the old clients, it is a Draw whose number is the message
 	GetRewardByRandom(context.Context, *GetRewardByRandomRequest) (*GetRewardByRandomResponse, error)
+	// Records a reward from a rule of config.yaml and queues its payout from the backend signer.
+	// A reward id is paid at most once: granting it again returns the stored reward, or ALREADY_EXISTS
+	// when the player, rule or quantity differ. FAILED_PRECONDITION when rewards are disabled
+	GrantReward(context.Context, *GrantRewardRequest) (*GrantRewardResponse, error)
+	GetRewardStatus(context.Context, *GetRewardStatusRequest) (*RewardRecord, error)
+	// Lists the rewards in grant order
+	ListRewards(context.Context, *ListRewardsRequest) (*ListRewardsResponse, error)
+	// Returns a draw round. The seed hash of the open round is published before its draws, the seed once
+	// the round is closed
+	GetDrawRound(context.Context, *GetDrawRoundRequest) (*DrawRound, error)
+	// Picks one of the weighted outcomes for a request id from keccak256(seed || request_id), in the open
+	// round. A request id is drawn once: drawing it again returns the stored draw, or ALREADY_EXISTS when
+	// the weights differ. FAILED_PRECONDITION when rewards are disabled
+	Draw(context.Context, *DrawRequest) (*DrawResponse, error)
+	// Returns a draw, with the seed of its round once revealed
+	GetDraw(context.Context, *GetDrawRequest) (*DrawResult, error)
+	// Recomputes a draw from the revealed seed, without the server state
+	VerifyDraw(context.Context, *VerifyDrawRequest) (*VerifyDrawResponse, error)
 	mustEmbedUnimplementedRewardServiceServer()
 }
 
@@ -55,6 +157,27 @@ type UnimplementedRewardServiceServer struct {
 func (UnimplementedRewardServiceServer) GetRewardByRandom(context.Context, *GetRewardByRandomRequest) (*GetRewardByRandomResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRewardByRandom not implemented")
 }
+func (UnimplementedRewardServiceServer) GrantReward(context.Context, *GrantRewardRequest) (*GrantRewardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantReward not implemented")
+}
+func (UnimplementedRewardServiceServer) GetRewardStatus(context.Context, *GetRewardStatusRequest) (*RewardRecord, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRewardStatus not implemented")
+}
+func (UnimplementedRewardServiceServer) ListRewards(context.Context, *ListRewardsRequest) (*ListRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRewards not implemented")
+}
+func (UnimplementedRewardServiceServer) GetDrawRound(context.Context, *GetDrawRoundRequest) (*DrawRound, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDrawRound not implemented")
+}
+func (UnimplementedRewardServiceServer) Draw(context.Context, *DrawRequest) (*DrawResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Draw not implemented")
+}
+func (UnimplementedRewardServiceServer) GetDraw(context.Context, *GetDrawRequest) (*DrawResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDraw not implemented")
+}
+func (UnimplementedRewardServiceServer) VerifyDraw(context.Context, *VerifyDrawRequest) (*VerifyDrawResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyDraw not implemented")
+}
 func (UnimplementedRewardServiceServer) mustEmbedUnimplementedRewardServiceServer() {}
 
 // UnsafeRewardServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -86,6 +209,132 @@ func _RewardService_GetRewardByRandom_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RewardService_GrantReward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GrantRewardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RewardServiceServer).GrantReward(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/reward_pb.RewardService/GrantReward",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RewardServiceServer).GrantReward(ctx, req.(*GrantRewardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RewardService_GetRewardStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRewardStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RewardServiceServer).GetRewardStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/reward_pb.RewardService/GetRewardStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RewardServiceServer).GetRewardStatus(ctx, req.(*GetRewardStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RewardService_ListRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RewardServiceServer).ListRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/reward_pb.RewardService/ListRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RewardServiceServer).ListRewards(ctx, req.(*ListRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RewardService_GetDrawRound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDrawRoundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RewardServiceServer).GetDrawRound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/reward_pb.RewardService/GetDrawRound",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RewardServiceServer).GetDrawRound(ctx, req.(*GetDrawRoundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RewardService_Draw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DrawRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RewardServiceServer).Draw(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/reward_pb.RewardService/Draw",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RewardServiceServer).Draw(ctx, req.(*DrawRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RewardService_GetDraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDrawRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RewardServiceServer).GetDraw(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/reward_pb.RewardService/GetDraw",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RewardServiceServer).GetDraw(ctx, req.(*GetDrawRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RewardService_VerifyDraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyDrawRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RewardServiceServer).VerifyDraw(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/reward_pb.RewardService/VerifyDraw",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RewardServiceServer).VerifyDraw(ctx, req.(*VerifyDrawRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // RewardService_ServiceDesc is the grpc.ServiceDesc for RewardService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -97,6 +346,34 @@ var RewardService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetRewardByRandom",
 			Handler:    _RewardService_GetRewardByRandom_Handler,
 		},
+		{
+			MethodName: "GrantReward",
+			Handler:    _RewardService_GrantReward_Handler,
+		},
+		{
+			MethodName: "GetRewardStatus",
+			Handler:    _RewardService_GetRewardStatus_Handler,
+		},
+		{
+			MethodName: "ListRewards",
+			Handler:    _RewardService_ListRewards_Handler,
+		},
+		{
+			MethodName: "GetDrawRound",
+			Handler:    _RewardService_GetDrawRound_Handler,
+		},
+		{
+			MethodName: "Draw",
+			Handler:    _RewardService_Draw_Handler,
+		},
+		{
+			MethodName: "GetDraw",
+			Handler:    _RewardService_GetDraw_Handler,
+		},
+		{
+			MethodName: "VerifyDraw",
+			Handler:    _RewardService_VerifyDraw_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "reward_pb/reward.proto",
@@ -2,12 +2,25 @@ package reward
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"strconv"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/mineloop99/new-token/back_end/features/reward/reward_pb"
+	"github.com/mineloop99/new-token/back_end/rewards"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
+const DEFAULT_PAGE_SIZE = 20
+const MAX_PAGE_SIZE = 100
+
+// Reward and draw request ids are keys of the ledger, keep them short
+const MAX_REWARD_ID_LENGTH = 128
+
 type Server struct {
 	reward_pb.UnimplementedRewardServiceServer
 }
@@ -16,10 +29,323 @@ func RewardRegister(s grpc.ServiceRegistrar) {
 	reward_pb.RegisterRewardServiceServer(s, &Server{})
 }
 
+// GetRewardByRandom returned the caller's number plus 2, it is now a draw of the open round
 func (*Server) GetRewardByRandom(ctx context.Context, in *reward_pb.GetRewardByRandomRequest) (*reward_pb.GetRewardByRandomResponse, error) {
-	message := in.GetNumber()
-	res := "Random Number is: " + strconv.FormatInt(message+2, 10)
+	engine := rewards.GetEngine()
+	if engine == nil {
+		return nil, status.Error(codes.FailedPrecondition, "GetRewardByRandom: Rewards are disabled")
+	}
+	requestId := in.GetRequestId()
+	if requestId == "" {
+		requestId = "random-" + strconv.FormatInt(in.GetNumber(), 10)
+	}
+	weights := in.GetWeights()
+	if len(weights) == 0 {
+		weights = []uint64{1}
+	}
+	result, _, err := draw(ctx, "GetRewardByRandom", engine, requestId, weights)
+	if err != nil {
+		return nil, err
+	}
 	return &reward_pb.GetRewardByRandomResponse{
-		Message: res,
+		Message: "Random Number is: " + result.Number,
+		Draw:    result,
 	}, nil
 }
+
+func (*Server) GrantReward(ctx context.Context, in *reward_pb.GrantRewardRequest) (*reward_pb.GrantRewardResponse, error) {
+	engine := rewards.GetEngine()
+	if engine == nil {
+		return nil, status.Error(codes.FailedPrecondition, "GrantReward: Rewards are disabled")
+	}
+	if in.GetRewardId() == "" || len(in.GetRewardId()) > MAX_REWARD_ID_LENGTH {
+		return nil, status.Errorf(codes.InvalidArgument, "GrantReward: The reward id must have 1 to %d characters", MAX_REWARD_ID_LENGTH)
+	}
+	if !common.IsHexAddress(in.GetPlayer()) {
+		return nil, status.Errorf(codes.InvalidArgument, "GrantReward: Invalid address %q", in.GetPlayer())
+	}
+	reward, created, err := engine.Grant(in.GetRewardId(), common.HexToAddress(in.GetPlayer()), in.GetRule(), in.GetQuantity())
+	switch {
+	case errors.Is(err, rewards.ErrUnknownRule):
+		return nil, status.Errorf(codes.NotFound, "GrantReward: %v", err)
+	case errors.Is(err, rewards.ErrInvalidGrant):
+		return nil, status.Errorf(codes.InvalidArgument, "GrantReward: %v", err)
+	case errors.Is(err, rewards.ErrRewardConflict):
+		return nil, status.Errorf(codes.AlreadyExists, "GrantReward: %q is already granted to %s for %d %s", reward.Id, reward.Player.Hex(), reward.Quantity, reward.Rule)
+	case err != nil:
+		return nil, status.Errorf(codes.Internal, "GrantReward: %v", err)
+	}
+	return &reward_pb.GrantRewardResponse{Reward: rewardRecord(reward), Created: created}, nil
+}
+
+func (*Server) GetRewardStatus(ctx context.Context, in *reward_pb.GetRewardStatusRequest) (*reward_pb.RewardRecord, error) {
+	engine := rewards.GetEngine()
+	if engine == nil {
+		return nil, status.Error(codes.FailedPrecondition, "GetRewardStatus: Rewards are disabled")
+	}
+	reward, ok, err := engine.Ledger().Get(in.GetRewardId())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "GetRewardStatus: Cannot read the ledger: %v", err)
+	}
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "GetRewardStatus: %q is not granted", in.GetRewardId())
+	}
+	return rewardRecord(reward), nil
+}
+
+func (*Server) ListRewards(ctx context.Context, in *reward_pb.ListRewardsRequest) (*reward_pb.ListRewardsResponse, error) {
+	engine := rewards.GetEngine()
+	if engine == nil {
+		return nil, status.Error(codes.FailedPrecondition, "ListRewards: Rewards are disabled")
+	}
+	var player common.Address
+	if in.GetPlayer() != "" {
+		if !common.IsHexAddress(in.GetPlayer()) {
+			return nil, status.Errorf(codes.InvalidArgument, "ListRewards: Invalid address %q", in.GetPlayer())
+		}
+		player = common.HexToAddress(in.GetPlayer())
+	}
+	pageSize := int(in.GetPageSize())
+	if pageSize == 0 {
+		pageSize = DEFAULT_PAGE_SIZE
+	}
+	if pageSize > MAX_PAGE_SIZE {
+		pageSize = MAX_PAGE_SIZE
+	}
+	// The page token is the sequence of the next reward
+	sequence := uint64(0)
+	if in.GetPageToken() != "" {
+		var err error
+		sequence, err = strconv.ParseUint(in.GetPageToken(), 10, 64)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "ListRewards: Invalid page token %q", in.GetPageToken())
+		}
+	}
+
+	response := &reward_pb.ListRewardsResponse{}
+	page := func(reward rewards.Reward) bool {
+		if len(response.Rewards) == pageSize {
+			response.NextPageToken = strconv.FormatUint(reward.Sequence, 10)
+			return false
+		}
+		record := rewardRecord(reward)
+		if in.GetStatus() != reward_pb.RewardStatus_REWARD_STATUS_UNSPECIFIED && record.Status != in.GetStatus() {
+			return true
+		}
+		response.Rewards = append(response.Rewards, record)
+		return true
+	}
+	// A player has few rewards, their status is checked on the page
+	var err error
+	switch {
+	case player != (common.Address{}):
+		err = engine.Ledger().RewardsOf(player, sequence, page)
+	case in.GetStatus() != reward_pb.RewardStatus_REWARD_STATUS_UNSPECIFIED:
+		wanted, ok := ledgerStatus(in.GetStatus())
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "ListRewards: Unknown status %v", in.GetStatus())
+		}
+		err = engine.Ledger().RewardsWithStatus(wanted, sequence, page)
+	default:
+		err = engine.Ledger().Rewards(sequence, page)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "ListRewards: Cannot read the ledger: %v", err)
+	}
+	return response, nil
+}
+
+func (*Server) GetDrawRound(ctx context.Context, in *reward_pb.GetDrawRoundRequest) (*reward_pb.DrawRound, error) {
+	engine := rewards.GetEngine()
+	if engine == nil {
+		return nil, status.Error(codes.FailedPrecondition, "GetDrawRound: Rewards are disabled")
+	}
+	var round rewards.Round
+	var err error
+	if in.GetRoundId() == 0 {
+		round, err = engine.Drawer().CurrentRound(ctx)
+	} else {
+		var ok bool
+		round, ok, err = engine.Ledger().GetRound(in.GetRoundId())
+		if err == nil && !ok {
+			return nil, status.Errorf(codes.NotFound, "GetDrawRound: Round %d is not opened", in.GetRoundId())
+		}
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "GetDrawRound: %v", err)
+	}
+	return drawRound(round), nil
+}
+
+func (*Server) Draw(ctx context.Context, in *reward_pb.DrawRequest) (*reward_pb.DrawResponse, error) {
+	engine := rewards.GetEngine()
+	if engine == nil {
+		return nil, status.Error(codes.FailedPrecondition, "Draw: Rewards are disabled")
+	}
+	result, created, err := draw(ctx, "Draw", engine, in.GetRequestId(), in.GetWeights())
+	if err != nil {
+		return nil, err
+	}
+	return &reward_pb.DrawResponse{Draw: result, Created: created}, nil
+}
+
+func (*Server) GetDraw(ctx context.Context, in *reward_pb.GetDrawRequest) (*reward_pb.DrawResult, error) {
+	engine := rewards.GetEngine()
+	if engine == nil {
+		return nil, status.Error(codes.FailedPrecondition, "GetDraw: Rewards are disabled")
+	}
+	draw, ok, err := engine.Ledger().GetDraw(in.GetRequestId())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "GetDraw: Cannot read the ledger: %v", err)
+	}
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "GetDraw: %q is not drawn", in.GetRequestId())
+	}
+	result, err := drawResult(engine.Ledger(), draw)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "GetDraw: %v", err)
+	}
+	return result, nil
+}
+
+// VerifyDraw needs no engine, anyone can run the same computation
+func (*Server) VerifyDraw(ctx context.Context, in *reward_pb.VerifyDrawRequest) (*reward_pb.VerifyDrawResponse, error) {
+	seed, err := hexutil.Decode(in.GetSeed())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "VerifyDraw: Invalid seed %q", in.GetSeed())
+	}
+	seedHash, err := hexutil.Decode(in.GetSeedHash())
+	if err != nil || len(seedHash) != common.HashLength {
+		return nil, status.Errorf(codes.InvalidArgument, "VerifyDraw: Invalid seed hash %q", in.GetSeedHash())
+	}
+	number, outcome, err := rewards.VerifyDraw(seed, common.BytesToHash(seedHash), in.GetRequestId(), in.GetWeights())
+	switch {
+	case errors.Is(err, rewards.ErrSeedMismatch):
+		return &reward_pb.VerifyDrawResponse{Valid: false}, nil
+	case err != nil:
+		return nil, status.Errorf(codes.InvalidArgument, "VerifyDraw: %v", err)
+	}
+	return &reward_pb.VerifyDrawResponse{Valid: true, Number: number.String(), Outcome: uint32(outcome)}, nil
+}
+
+// draw draws requestId in the open round, the errors are statuses of the rpc method
+func draw(ctx context.Context, method string, engine *rewards.Engine, requestId string, weights []uint64) (*reward_pb.DrawResult, bool, error) {
+	if requestId == "" || len(requestId) > MAX_REWARD_ID_LENGTH {
+		return nil, false, status.Errorf(codes.InvalidArgument, "%s: The request id must have 1 to %d characters", method, MAX_REWARD_ID_LENGTH)
+	}
+	draw, created, err := engine.Drawer().Draw(ctx, requestId, weights)
+	switch {
+	case errors.Is(err, rewards.ErrInvalidDraw):
+		return nil, false, status.Errorf(codes.InvalidArgument, "%s: %v", method, err)
+	case errors.Is(err, rewards.ErrDrawConflict):
+		return nil, false, status.Errorf(codes.AlreadyExists, "%s: %q is already drawn with weights %v", method, draw.RequestId, draw.Weights)
+	case err != nil:
+		return nil, false, status.Errorf(codes.Internal, "%s: %v", method, err)
+	}
+	result, err := drawResult(engine.Ledger(), draw)
+	if err != nil {
+		return nil, false, status.Errorf(codes.Internal, "%s: %v", method, err)
+	}
+	return result, created, nil
+}
+
+func drawRound(round rewards.Round) *reward_pb.DrawRound {
+	result := &reward_pb.DrawRound{
+		RoundId:   round.Id,
+		Source:    round.Source,
+		SeedHash:  round.SeedHash.Hex(),
+		Revealed:  round.Revealed,
+		Draws:     round.Draws,
+		OpenedAt:  round.OpenedAt,
+		ClosedAt:  round.ClosedAt,
+		ExpiresAt: round.ExpiresAt,
+	}
+	if round.Revealed {
+		result.Seed = hexutil.Encode(round.Secret)
+	}
+	return result
+}
+
+func drawResult(ledger *rewards.Ledger, draw rewards.Draw) (*reward_pb.DrawResult, error) {
+	round, ok, err := ledger.GetRound(draw.Round)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, fmt.Errorf("round %d of %q is missing", draw.Round, draw.RequestId)
+	}
+	result := &reward_pb.DrawResult{
+		RequestId: draw.RequestId,
+		RoundId:   draw.Round,
+		SeedHash:  round.SeedHash.Hex(),
+		Weights:   draw.Weights,
+		Number:    draw.Number.String(),
+		Outcome:   uint32(draw.Outcome),
+		CreatedAt: draw.CreatedAt,
+	}
+	if round.Revealed {
+		result.Seed = hexutil.Encode(round.Secret)
+	}
+	return result, nil
+}
+
+func rewardRecord(reward rewards.Reward) *reward_pb.RewardRecord {
+	record := &reward_pb.RewardRecord{
+		RewardId:  reward.Id,
+		Player:    reward.Player.Hex(),
+		Rule:      reward.Rule,
+		Quantity:  reward.Quantity,
+		Items:     reward.Items,
+		Status:    rewardStatus(reward.Status),
+		Error:     reward.Error,
+		CreatedAt: reward.CreatedAt,
+		UpdatedAt: reward.UpdatedAt,
+	}
+	switch reward.Kind {
+	case rewards.PAYOUT_ANI:
+		record.Kind = reward_pb.RewardKind_REWARD_KIND_ANI
+	case rewards.PAYOUT_NFT:
+		record.Kind = reward_pb.RewardKind_REWARD_KIND_NFT
+	}
+	if reward.Amount != nil {
+		record.Amount = reward.Amount.String()
+	}
+	if reward.TxHash != (common.Hash{}) {
+		record.TxHash = reward.TxHash.Hex()
+	}
+	for _, tokenId := range reward.TokenIds {
+		record.TokenIds = append(record.TokenIds, tokenId.String())
+	}
+	for _, hash := range reward.TransferTxHashes {
+		record.TransferTxHashes = append(record.TransferTxHashes, hash.Hex())
+	}
+	return record
+}
+
+func rewardStatus(s rewards.RewardStatus) reward_pb.RewardStatus {
+	switch s {
+	case rewards.STATUS_QUEUED:
+		return reward_pb.RewardStatus_REWARD_STATUS_QUEUED
+	case rewards.STATUS_SENT:
+		return reward_pb.RewardStatus_REWARD_STATUS_SENT
+	case rewards.STATUS_MINTED:
+		return reward_pb.RewardStatus_REWARD_STATUS_MINTED
+	case rewards.STATUS_PAID:
+		return reward_pb.RewardStatus_REWARD_STATUS_PAID
+	case rewards.STATUS_FAILED:
+		return reward_pb.RewardStatus_REWARD_STATUS_FAILED
+	case rewards.STATUS_UNKNOWN:
+		return reward_pb.RewardStatus_REWARD_STATUS_UNKNOWN
+	}
+	return reward_pb.RewardStatus_REWARD_STATUS_UNSPECIFIED
+}
+
+// ledgerStatus is the reverse of rewardStatus
+func ledgerStatus(s reward_pb.RewardStatus) (rewards.RewardStatus, bool) {
+	for _, status := range []rewards.RewardStatus{rewards.STATUS_QUEUED, rewards.STATUS_SENT, rewards.STATUS_MINTED, rewards.STATUS_PAID, rewards.STATUS_FAILED, rewards.STATUS_UNKNOWN} {
+		if rewardStatus(status) == s {
+			return status, true
+		}
+	}
+	return "", false
+}
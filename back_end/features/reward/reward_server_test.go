@@ -0,0 +1,282 @@
+package reward
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/mineloop99/new-token/back_end/features/reward/reward_pb"
+	"github.com/mineloop99/new-token/back_end/rewards"
+	"github.com/mineloop99/new-token/back_end/utils"
+	"github.com/mineloop99/new-token/back_end/utils/configtest"
+	"github.com/mineloop99/new-token/back_end/utils/utilstest"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var testRules = map[string]rewards.Rule{
+	"dailyLogin": {Name: "dailyLogin", Ani: big.NewInt(10), MaxQuantity: 7},
+	"bossDrop":   {Name: "bossDrop", Items: []string{"Sword", "Shield"}, MaxQuantity: 1},
+}
+
+var player = common.HexToAddress("0x0000000000000000000000000000000000000007")
+
+// newTestConfig deploys AniwarToken and AniwarNft, the chain deployer signs the payouts
+func newTestConfig(t *testing.T) (*utilstest.Chain, utils.Config) {
+	t.Helper()
+	chain := utilstest.NewChain(t)
+	token := configtest.Deploy(t, chain, utils.ANIWAR_TOKEN)
+	return chain, configtest.New(chain, token, configtest.Deploy(t, chain, utils.ANIWAR_NFT, token.Address))
+}
+
+// useEngine makes an engine paying from config the one the server uses until the test ends
+func useEngine(t *testing.T, config utils.Config) *rewards.Engine {
+	e := rewards.NewEngine(config, rewards.NewMemoryLedger(), testRules)
+	rewards.SetEngine(e)
+	t.Cleanup(func() { rewards.SetEngine(nil) })
+	return e
+}
+
+// runEngine runs the payout queue until the test ends
+func runEngine(t *testing.T, e *rewards.Engine) {
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		e.Run(ctx)
+		close(done)
+	}()
+	t.Cleanup(func() {
+		cancel()
+		<-done
+	})
+}
+
+// waitFor polls the reward until ok accepts it
+func waitFor(t *testing.T, rewardId string, ok func(*reward_pb.RewardRecord) bool) *reward_pb.RewardRecord {
+	t.Helper()
+	deadline := time.Now().Add(time.Second * 5)
+	for {
+		record, err := (&Server{}).GetRewardStatus(context.Background(), &reward_pb.GetRewardStatusRequest{RewardId: rewardId})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ok(record) {
+			return record
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("%s is stuck at %v", rewardId, record)
+		}
+		time.Sleep(configtest.POLL_INTERVAL)
+	}
+}
+
+func TestGrantRewardOnce(t *testing.T) {
+	server := &Server{}
+	ctx := context.Background()
+	request := &reward_pb.GrantRewardRequest{RewardId: "login-1", Player: player.Hex(), Rule: "dailyLogin", Quantity: 3}
+	if _, err := server.GrantReward(ctx, request); status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("rewards disabled: err %v", err)
+	}
+	_, config := newTestConfig(t)
+	useEngine(t, config)
+
+	response, err := server.GrantReward(ctx, request)
+	if err != nil || !response.GetCreated() {
+		t.Fatalf("grant: %v, err %v", response, err)
+	}
+	reward := response.GetReward()
+	if reward.GetStatus() != reward_pb.RewardStatus_REWARD_STATUS_QUEUED || reward.GetKind() != reward_pb.RewardKind_REWARD_KIND_ANI || reward.GetAmount() != "30" {
+		t.Fatalf("granted %v", reward)
+	}
+	again, err := server.GrantReward(ctx, request)
+	if err != nil || again.GetCreated() || again.GetReward().GetCreatedAt() != reward.GetCreatedAt() {
+		t.Fatalf("grant again: %v, err %v", again, err)
+	}
+
+	for _, test := range []struct {
+		name    string
+		request *reward_pb.GrantRewardRequest
+		code    codes.Code
+	}{
+		{"other quantity", &reward_pb.GrantRewardRequest{RewardId: "login-1", Player: player.Hex(), Rule: "dailyLogin", Quantity: 4}, codes.AlreadyExists},
+		{"unknown rule", &reward_pb.GrantRewardRequest{RewardId: "login-2", Player: player.Hex(), Rule: "weeklyLogin"}, codes.NotFound},
+		{"over the rule", &reward_pb.GrantRewardRequest{RewardId: "login-2", Player: player.Hex(), Rule: "dailyLogin", Quantity: 8}, codes.InvalidArgument},
+		{"invalid player", &reward_pb.GrantRewardRequest{RewardId: "login-2", Player: "0x07", Rule: "dailyLogin"}, codes.InvalidArgument},
+		{"no reward id", &reward_pb.GrantRewardRequest{Player: player.Hex(), Rule: "dailyLogin"}, codes.InvalidArgument},
+	} {
+		if _, err := server.GrantReward(ctx, test.request); status.Code(err) != test.code {
+			t.Errorf("%s: err %v, want %v", test.name, err, test.code)
+		}
+	}
+	if _, err := server.GetRewardStatus(ctx, &reward_pb.GetRewardStatusRequest{RewardId: "login-2"}); status.Code(err) != codes.NotFound {
+		t.Errorf("refused grant: err %v", err)
+	}
+}
+
+func TestGrantRewardPaysItems(t *testing.T) {
+	chain, config := newTestConfig(t)
+	// the signer pays the mint fee of the 2 items, 200 ANI with 18 decimals each
+	token, nft := config.Contracts[utils.ANIWAR_TOKEN], config.Contracts[utils.ANIWAR_NFT]
+	fee := new(big.Int).Mul(big.NewInt(400), new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil))
+	chain.Transact(t, token.Address, token.ABI, "approve", nft.Address, fee)
+	runEngine(t, useEngine(t, config))
+	server := &Server{}
+
+	response, err := server.GrantReward(context.Background(), &reward_pb.GrantRewardRequest{RewardId: "boss-1", Player: player.Hex(), Rule: "bossDrop"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reward := response.GetReward(); reward.GetStatus() != reward_pb.RewardStatus_REWARD_STATUS_QUEUED || reward.GetKind() != reward_pb.RewardKind_REWARD_KIND_NFT {
+		t.Fatalf("granted %v", reward)
+	}
+	// every step waits for the block of the previous transaction
+	waitFor(t, "boss-1", func(reward *reward_pb.RewardRecord) bool {
+		return reward.GetStatus() == reward_pb.RewardStatus_REWARD_STATUS_SENT && reward.GetTxHash() != ""
+	})
+	chain.Commit()
+	minted := waitFor(t, "boss-1", func(reward *reward_pb.RewardRecord) bool {
+		return reward.GetStatus() == reward_pb.RewardStatus_REWARD_STATUS_MINTED && len(reward.GetTransferTxHashes()) == 2
+	})
+	if len(minted.GetTokenIds()) != 2 {
+		t.Fatalf("minted %v", minted)
+	}
+	chain.Commit()
+	waitFor(t, "boss-1", func(reward *reward_pb.RewardRecord) bool {
+		return reward.GetStatus() == reward_pb.RewardStatus_REWARD_STATUS_PAID
+	})
+	for _, tokenId := range minted.GetTokenIds() {
+		id, _ := new(big.Int).SetString(tokenId, 10)
+		result, err := utils.CallViewMethods(config, utils.ANIWAR_NFT, "ownerOf", big.NewInt(0), id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if owner := result[0].(common.Address); owner != player {
+			t.Errorf("item %s is owned by %s, want the player", tokenId, owner.Hex())
+		}
+	}
+
+	paid, err := server.ListRewards(context.Background(), &reward_pb.ListRewardsRequest{Status: reward_pb.RewardStatus_REWARD_STATUS_PAID})
+	if err != nil || len(paid.GetRewards()) != 1 || paid.GetRewards()[0].GetRewardId() != "boss-1" {
+		t.Fatalf("paid rewards: %v, err %v", paid, err)
+	}
+}
+
+func TestGrantRewardFailsOnARevertingPayout(t *testing.T) {
+	chain, config := newTestConfig(t)
+	// the transfer is estimated, then mined out of gas
+	config.Gas.Overrides = map[string]uint64{utils.ANIWAR_TOKEN + ".transfer": 30000}
+	runEngine(t, useEngine(t, config))
+
+	if _, err := (&Server{}).GrantReward(context.Background(), &reward_pb.GrantRewardRequest{RewardId: "login-1", Player: player.Hex(), Rule: "dailyLogin"}); err != nil {
+		t.Fatal(err)
+	}
+	sent := waitFor(t, "login-1", func(reward *reward_pb.RewardRecord) bool {
+		return reward.GetStatus() == reward_pb.RewardStatus_REWARD_STATUS_SENT
+	})
+	chain.Commit()
+	failed := waitFor(t, "login-1", func(reward *reward_pb.RewardRecord) bool {
+		return reward.GetStatus() == reward_pb.RewardStatus_REWARD_STATUS_FAILED
+	})
+	if failed.GetTxHash() != sent.GetTxHash() || failed.GetError() == "" {
+		t.Fatalf("failed %v", failed)
+	}
+}
+
+func TestListRewardsPages(t *testing.T) {
+	_, config := newTestConfig(t)
+	useEngine(t, config)
+	server := &Server{}
+	ctx := context.Background()
+	other := common.HexToAddress("0x0000000000000000000000000000000000000008")
+	for _, grant := range []struct {
+		id     string
+		player common.Address
+	}{{"login-1", player}, {"login-2", other}, {"login-3", player}, {"login-4", player}} {
+		if _, err := server.GrantReward(ctx, &reward_pb.GrantRewardRequest{RewardId: grant.id, Player: grant.player.Hex(), Rule: "dailyLogin"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// list pages request until the last page and returns the reward ids
+	list := func(request *reward_pb.ListRewardsRequest) []string {
+		t.Helper()
+		var ids []string
+		for {
+			response, err := server.ListRewards(ctx, request)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(response.GetRewards()) > int(request.GetPageSize()) {
+				t.Fatalf("page of %d rewards, size %d", len(response.GetRewards()), request.GetPageSize())
+			}
+			for _, reward := range response.GetRewards() {
+				ids = append(ids, reward.GetRewardId())
+			}
+			if response.GetNextPageToken() == "" {
+				return ids
+			}
+			request.PageToken = response.GetNextPageToken()
+		}
+	}
+	for _, test := range []struct {
+		name    string
+		request *reward_pb.ListRewardsRequest
+		want    []string
+	}{
+		{"all", &reward_pb.ListRewardsRequest{PageSize: 3}, []string{"login-1", "login-2", "login-3", "login-4"}},
+		{"player", &reward_pb.ListRewardsRequest{Player: player.Hex(), PageSize: 1}, []string{"login-1", "login-3", "login-4"}},
+		{"status", &reward_pb.ListRewardsRequest{Status: reward_pb.RewardStatus_REWARD_STATUS_QUEUED, PageSize: 2}, []string{"login-1", "login-2", "login-3", "login-4"}},
+		{"player and status", &reward_pb.ListRewardsRequest{Player: other.Hex(), Status: reward_pb.RewardStatus_REWARD_STATUS_QUEUED, PageSize: 2}, []string{"login-2"}},
+		{"no match", &reward_pb.ListRewardsRequest{Player: other.Hex(), Status: reward_pb.RewardStatus_REWARD_STATUS_PAID, PageSize: 2}, nil},
+	} {
+		got := list(test.request)
+		if len(got) != len(test.want) {
+			t.Errorf("%s: %v, want %v", test.name, got, test.want)
+			continue
+		}
+		for i := range got {
+			if got[i] != test.want[i] {
+				t.Errorf("%s: %v, want %v", test.name, got, test.want)
+				break
+			}
+		}
+	}
+	if _, err := server.ListRewards(ctx, &reward_pb.ListRewardsRequest{PageToken: "login-2"}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("invalid page token: err %v", err)
+	}
+}
+
+func TestGetRewardByRandomDraws(t *testing.T) {
+	_, config := newTestConfig(t)
+	useEngine(t, config)
+	server := &Server{}
+	ctx := context.Background()
+
+	response, err := server.GetRewardByRandom(ctx, &reward_pb.GetRewardByRandomRequest{Number: 5})
+	if err != nil {
+		t.Fatal(err)
+	}
+	draw := response.GetDraw()
+	if draw.GetRequestId() != "random-5" || draw.GetOutcome() != 0 || response.GetMessage() != "Random Number is: "+draw.GetNumber() {
+		t.Fatalf("drawn %v", response)
+	}
+	// the number of a request id is drawn once
+	again, err := server.GetRewardByRandom(ctx, &reward_pb.GetRewardByRandomRequest{Number: 5})
+	if err != nil || again.GetDraw().GetNumber() != draw.GetNumber() {
+		t.Fatalf("draw again: %v, err %v", again, err)
+	}
+	stored, err := server.GetDraw(ctx, &reward_pb.GetDrawRequest{RequestId: "random-5"})
+	if err != nil || stored.GetNumber() != draw.GetNumber() || stored.GetSeedHash() != draw.GetSeedHash() {
+		t.Fatalf("stored draw: %v, err %v", stored, err)
+	}
+
+	weighted, err := server.GetRewardByRandom(ctx, &reward_pb.GetRewardByRandomRequest{RequestId: "chest-1", Weights: []uint64{70, 30}})
+	if err != nil || weighted.GetDraw().GetRequestId() != "chest-1" || weighted.GetDraw().GetOutcome() > 1 {
+		t.Fatalf("weighted draw: %v, err %v", weighted, err)
+	}
+	if _, err := server.GetRewardByRandom(ctx, &reward_pb.GetRewardByRandomRequest{RequestId: "chest-1", Weights: []uint64{1}}); status.Code(err) != codes.AlreadyExists {
+		t.Fatalf("draw with other weights: err %v", err)
+	}
+}
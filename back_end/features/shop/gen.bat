@@ -0,0 +1 @@
+start protoc --go_out=. --go-grpc_out=. ./shop_pb/shop.proto
\ No newline at end of file
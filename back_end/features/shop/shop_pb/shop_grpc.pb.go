@@ -0,0 +1,252 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package shop_pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// ShopServiceClient is the client API for ShopService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ShopServiceClient interface {
+	// Lists the items sold by SpendAni
+	ListShopItems(ctx context.Context, in *ListShopItemsRequest, opts ...grpc.CallOption) (*ListShopItemsResponse, error)
+	// Admin methods, sent from the signer holding CREATOR_ADMIN_SERVER
+	AddShopItem(ctx context.Context, in *AddShopItemRequest, opts ...grpc.CallOption) (*ShopTxResponse, error)
+	RemoveShopItem(ctx context.Context, in *RemoveShopItemRequest, opts ...grpc.CallOption) (*ShopTxResponse, error)
+	// Streams confirmed PurchaseItem events from a block. The first request must be a start,
+	// every purchase must then be acked. Unacked purchases are sent again, so the receiver must
+	// dedupe by id. To resume, start again from the block of the oldest unacked purchase
+	StreamPurchases(ctx context.Context, opts ...grpc.CallOption) (ShopService_StreamPurchasesClient, error)
+}
+
+type shopServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewShopServiceClient(cc grpc.ClientConnInterface) ShopServiceClient {
+	return &shopServiceClient{cc}
+}
+
+func (c *shopServiceClient) ListShopItems(ctx context.Context, in *ListShopItemsRequest, opts ...grpc.CallOption) (*ListShopItemsResponse, error) {
+	out := new(ListShopItemsResponse)
+	err := c.cc.Invoke(ctx, "/shop_pb.ShopService/ListShopItems", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shopServiceClient) AddShopItem(ctx context.Context, in *AddShopItemRequest, opts ...grpc.CallOption) (*ShopTxResponse, error) {
+	out := new(ShopTxResponse)
+	err := c.cc.Invoke(ctx, "/shop_pb.ShopService/AddShopItem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shopServiceClient) RemoveShopItem(ctx context.Context, in *RemoveShopItemRequest, opts ...grpc.CallOption) (*ShopTxResponse, error) {
+	out := new(ShopTxResponse)
+	err := c.cc.Invoke(ctx, "/shop_pb.ShopService/RemoveShopItem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shopServiceClient) StreamPurchases(ctx context.Context, opts ...grpc.CallOption) (ShopService_StreamPurchasesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ShopService_ServiceDesc.Streams[0], "/shop_pb.ShopService/StreamPurchases", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &shopServiceStreamPurchasesClient{stream}
+	return x, nil
+}
+
+type ShopService_StreamPurchasesClient interface {
+	Send(*StreamPurchasesRequest) error
+	Recv() (*Purchase, error)
+	grpc.ClientStream
+}
+
+type shopServiceStreamPurchasesClient struct {
+	grpc.ClientStream
+}
+
+func (x *shopServiceStreamPurchasesClient) Send(m *StreamPurchasesRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *shopServiceStreamPurchasesClient) Recv() (*Purchase, error) {
+	m := new(Purchase)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// ShopServiceServer is the server API for ShopService service.
+// All implementations must embed UnimplementedShopServiceServer
+// for forward compatibility
+type ShopServiceServer interface {
+	// Lists the items sold by SpendAni
+	ListShopItems(context.Context, *ListShopItemsRequest) (*ListShopItemsResponse, error)
+	// Admin methods, sent from the signer holding CREATOR_ADMIN_SERVER
+	AddShopItem(context.Context, *AddShopItemRequest) (*ShopTxResponse, error)
+	RemoveShopItem(context.Context, *RemoveShopItemRequest) (*ShopTxResponse, error)
+	// Streams confirmed PurchaseItem events from a block. The first request must be a start,
+	// every purchase must then be acked. Unacked purchases are sent again, so the receiver must
+	// dedupe by id. To resume, start again from the block of the oldest unacked purchase
+	StreamPurchases(ShopService_StreamPurchasesServer) error
+	mustEmbedUnimplementedShopServiceServer()
+}
+
+// UnimplementedShopServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedShopServiceServer struct {
+}
+
+func (UnimplementedShopServiceServer) ListShopItems(context.Context, *ListShopItemsRequest) (*ListShopItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListShopItems not implemented")
+}
+func (UnimplementedShopServiceServer) AddShopItem(context.Context, *AddShopItemRequest) (*ShopTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddShopItem not implemented")
+}
+func (UnimplementedShopServiceServer) RemoveShopItem(context.Context, *RemoveShopItemRequest) (*ShopTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveShopItem not implemented")
+}
+func (UnimplementedShopServiceServer) StreamPurchases(ShopService_StreamPurchasesServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamPurchases not implemented")
+}
+func (UnimplementedShopServiceServer) mustEmbedUnimplementedShopServiceServer() {}
+
+// UnsafeShopServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ShopServiceServer will
+// result in compilation errors.
+type UnsafeShopServiceServer interface {
+	mustEmbedUnimplementedShopServiceServer()
+}
+
+func RegisterShopServiceServer(s grpc.ServiceRegistrar, srv ShopServiceServer) {
+	s.RegisterService(&ShopService_ServiceDesc, srv)
+}
+
+func _ShopService_ListShopItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListShopItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShopServiceServer).ListShopItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shop_pb.ShopService/ListShopItems",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShopServiceServer).ListShopItems(ctx, req.(*ListShopItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShopService_AddShopItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddShopItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShopServiceServer).AddShopItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shop_pb.ShopService/AddShopItem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShopServiceServer).AddShopItem(ctx, req.(*AddShopItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShopService_RemoveShopItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveShopItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShopServiceServer).RemoveShopItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shop_pb.ShopService/RemoveShopItem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShopServiceServer).RemoveShopItem(ctx, req.(*RemoveShopItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShopService_StreamPurchases_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ShopServiceServer).StreamPurchases(&shopServiceStreamPurchasesServer{stream})
+}
+
+type ShopService_StreamPurchasesServer interface {
+	Send(*Purchase) error
+	Recv() (*StreamPurchasesRequest, error)
+	grpc.ServerStream
+}
+
+type shopServiceStreamPurchasesServer struct {
+	grpc.ServerStream
+}
+
+func (x *shopServiceStreamPurchasesServer) Send(m *Purchase) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *shopServiceStreamPurchasesServer) Recv() (*StreamPurchasesRequest, error) {
+	m := new(StreamPurchasesRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// ShopService_ServiceDesc is the grpc.ServiceDesc for ShopService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ShopService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "shop_pb.ShopService",
+	HandlerType: (*ShopServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListShopItems",
+			Handler:    _ShopService_ListShopItems_Handler,
+		},
+		{
+			MethodName: "AddShopItem",
+			Handler:    _ShopService_AddShopItem_Handler,
+		},
+		{
+			MethodName: "RemoveShopItem",
+			Handler:    _ShopService_RemoveShopItem_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamPurchases",
+			Handler:       _ShopService_StreamPurchases_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "shop_pb/shop.proto",
+}
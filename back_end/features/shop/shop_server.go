@@ -0,0 +1,282 @@
+package shop
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/mineloop99/new-token/back_end/features/shop/shop_pb"
+	"github.com/mineloop99/new-token/back_end/utils"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Purchases sent and not acked yet, the stream waits for acks past this
+const MAX_UNACKED = 100
+
+// Unacked purchases are sent again after this delay
+const ACK_TIMEOUT = time.Second * 30
+
+const DEFAULT_POLL_INTERVAL = time.Second * 3
+
+// Blocks scanned per poll, in LogRange units, so a replay from far back is sent progressively
+const POLL_LOG_RANGES = 10
+
+type Server struct {
+	shop_pb.UnimplementedShopServiceServer
+}
+
+func RewardRegister(s grpc.ServiceRegistrar) {
+	shop_pb.RegisterShopServiceServer(s, &Server{})
+}
+
+func (*Server) ListShopItems(ctx context.Context, in *shop_pb.ListShopItemsRequest) (*shop_pb.ListShopItemsResponse, error) {
+	config, err := utils.GetConfig()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "ListShopItems: Cannot get config: %v", err)
+	}
+	items, err := shopItems(config)
+	if err != nil {
+		return nil, utils.StatusError(err)
+	}
+	return &shop_pb.ListShopItemsResponse{Items: items}, nil
+}
+
+func (*Server) AddShopItem(ctx context.Context, in *shop_pb.AddShopItemRequest) (*shop_pb.ShopTxResponse, error) {
+	config, err := utils.GetConfig()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "AddShopItem: Cannot get config: %v", err)
+	}
+	if in.GetName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "AddShopItem: Name is empty")
+	}
+	price, ok := new(big.Int).SetString(in.GetPrice(), 10)
+	if !ok || price.Sign() <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "AddShopItem: Invalid price %q", in.GetPrice())
+	}
+	// addItemShop pushes the name again even when it is listed, leaving duplicates in getAllShopInfo
+	items, err := shopItems(config)
+	if err != nil {
+		return nil, utils.StatusError(err)
+	}
+	for _, item := range items {
+		if item.Name == in.GetName() {
+			return nil, status.Errorf(codes.AlreadyExists, "AddShopItem: %q is already sold for %s", item.Name, item.Price)
+		}
+	}
+	tx, err := utils.CallMethods(config, utils.SPEND_ANI, "addItemShop", big.NewInt(0), in.GetName(), price)
+	if err != nil {
+		return nil, utils.StatusError(err)
+	}
+	return &shop_pb.ShopTxResponse{TxHash: tx.Hash().Hex()}, nil
+}
+
+func (*Server) RemoveShopItem(ctx context.Context, in *shop_pb.RemoveShopItemRequest) (*shop_pb.ShopTxResponse, error) {
+	config, err := utils.GetConfig()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "RemoveShopItem: Cannot get config: %v", err)
+	}
+	items, err := shopItems(config)
+	if err != nil {
+		return nil, utils.StatusError(err)
+	}
+	found := false
+	for _, item := range items {
+		found = found || item.Name == in.GetName()
+	}
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "RemoveShopItem: %q is not sold", in.GetName())
+	}
+	tx, err := utils.CallMethods(config, utils.SPEND_ANI, "removeItemShop", big.NewInt(0), in.GetName())
+	if err != nil {
+		return nil, utils.StatusError(err)
+	}
+	return &shop_pb.ShopTxResponse{TxHash: tx.Hash().Hex()}, nil
+}
+
+type sentPurchase struct {
+	purchase *shop_pb.Purchase
+	sentAt   time.Time
+	acked    bool
+}
+
+func (*Server) StreamPurchases(stream shop_pb.ShopService_StreamPurchasesServer) error {
+	config, err := utils.GetConfig()
+	if err != nil {
+		return status.Errorf(codes.Internal, "StreamPurchases: Cannot get config: %v", err)
+	}
+	request, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	start := request.GetStart()
+	if start == nil {
+		return status.Error(codes.InvalidArgument, "StreamPurchases: The first request must be a start")
+	}
+	contract, err := config.GetContract(utils.SPEND_ANI)
+	if err != nil {
+		return utils.StatusError(err)
+	}
+	ctx := stream.Context()
+
+	acks := make(chan string)
+	recvErr := make(chan error, 1)
+	go func() {
+		for {
+			request, err := stream.Recv()
+			if err != nil {
+				recvErr <- err
+				return
+			}
+			if ack := request.GetAck(); ack != nil {
+				select {
+				case acks <- ack.GetId():
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	pollInterval := config.Tracker.PollInterval()
+	if pollInterval <= 0 {
+		pollInterval = DEFAULT_POLL_INTERVAL
+	}
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+	logRange := config.LogRange
+	if logRange == 0 {
+		logRange = utils.DEFAULT_LOG_RANGE
+	}
+	scanBlocks := logRange * POLL_LOG_RANGES
+
+	next := start.GetFromBlock()
+	if next < contract.StartBlock {
+		next = contract.StartBlock
+	}
+	var queue []*shop_pb.Purchase
+	var inflight []*sentPurchase
+	unacked := make(map[string]*sentPurchase)
+	poll := true
+	for {
+		if poll && len(queue) == 0 {
+			purchases, nextBlock, err := confirmedPurchases(ctx, config, next, scanBlocks)
+			if err != nil {
+				return utils.StatusError(err)
+			}
+			queue = purchases
+			// A full range was scanned, more confirmed blocks may be left: do not wait for the ticker
+			poll = nextBlock-next == scanBlocks
+			next = nextBlock
+		}
+
+		for len(queue) > 0 && len(unacked) < MAX_UNACKED {
+			purchase := queue[0]
+			queue = queue[1:]
+			if err := stream.Send(purchase); err != nil {
+				return err
+			}
+			sent := &sentPurchase{purchase: purchase, sentAt: time.Now()}
+			inflight = append(inflight, sent)
+			unacked[purchase.Id] = sent
+		}
+
+		// Send again the oldest unacked purchases, inflight is in send order
+		remaining := inflight[:0]
+		for _, sent := range inflight {
+			if sent.acked {
+				continue
+			}
+			if time.Since(sent.sentAt) > ACK_TIMEOUT {
+				sent.purchase.Redelivered = true
+				if err := stream.Send(sent.purchase); err != nil {
+					return err
+				}
+				sent.sentAt = time.Now()
+			}
+			remaining = append(remaining, sent)
+		}
+		inflight = remaining
+
+		select {
+		case id := <-acks:
+			if sent, ok := unacked[id]; ok {
+				sent.acked = true
+				delete(unacked, id)
+			}
+		case err := <-recvErr:
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		case <-ticker.C:
+			poll = true
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// confirmedPurchases returns the PurchaseItem events of the confirmed blocks from fromBlock, scanning at
+// most maxBlocks, and the first block left to scan
+func confirmedPurchases(ctx context.Context, config utils.Config, fromBlock uint64, maxBlocks uint64) ([]*shop_pb.Purchase, uint64, error) {
+	header, err := config.Client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, 0, &utils.RpcError{Method: "eth_getBlockByNumber", Err: err}
+	}
+	head := header.Number.Uint64()
+	confirmations := config.Tracker.Confirmations()
+	if head+1 < confirmations || head+1-confirmations < fromBlock {
+		return nil, fromBlock, nil
+	}
+	last := head + 1 - confirmations
+	if last-fromBlock+1 > maxBlocks {
+		last = fromBlock + maxBlocks - 1
+	}
+
+	events, err := utils.FilterEvents(ctx, config, utils.SPEND_ANI, []string{"PurchaseItem"}, fromBlock, new(big.Int).SetUint64(last))
+	if err != nil {
+		return nil, 0, err
+	}
+	purchases := make([]*shop_pb.Purchase, 0, len(events))
+	for _, event := range events {
+		// PurchaseItem(address Owner, uint256 fee, string itemName, uint256 timePurchaseItem)
+		purchases = append(purchases, &shop_pb.Purchase{
+			Id:           fmt.Sprintf("%s:%d", event.Log.TxHash.Hex(), event.Log.Index),
+			Owner:        event.Args["Owner"].(common.Address).Hex(),
+			Fee:          event.Args["fee"].(*big.Int).String(),
+			ItemName:     event.Args["itemName"].(string),
+			PurchaseTime: event.Args["timePurchaseItem"].(*big.Int).Uint64(),
+			BlockNumber:  event.Log.BlockNumber,
+			BlockHash:    event.Log.BlockHash.Hex(),
+			TxHash:       event.Log.TxHash.Hex(),
+			LogIndex:     uint32(event.Log.Index),
+		})
+	}
+	return purchases, last + 1, nil
+}
+
+// shopItems reads getAllShopInfo. removeItemShop leaves an empty name and a 0 price behind, and
+// addItemShop can list a name twice, only the sold names are returned, once
+func shopItems(config utils.Config) ([]*shop_pb.ShopItem, error) {
+	result, err := utils.CallViewMethods(config, utils.SPEND_ANI, "getAllShopInfo", big.NewInt(0))
+	if err != nil {
+		return nil, err
+	}
+	names := result[0].([]string)
+	prices := result[1].([]*big.Int)
+	seen := make(map[string]bool)
+	var items []*shop_pb.ShopItem
+	for i, name := range names {
+		if name == "" || prices[i].Sign() == 0 || seen[name] {
+			continue
+		}
+		seen[name] = true
+		items = append(items, &shop_pb.ShopItem{Name: name, Price: prices[i].String()})
+	}
+	return items, nil
+}
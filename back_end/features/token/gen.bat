@@ -1 +1 @@
-start protoc --go_out=. --go-grpc_out=. token_pb/token.proto
\ No newline at end of file
+start protoc -I . -I .. --go_out=. --go-grpc_out=. token_pb/token.proto
\ No newline at end of file
@@ -2,13 +2,14 @@ package token
 
 import (
 	"context"
-	"log"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/mineloop99/new-token/back_end/features/token/token_pb"
 	"github.com/mineloop99/new-token/back_end/utils"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type Server struct {
@@ -22,13 +23,22 @@ func RewardRegister(s grpc.ServiceRegistrar) {
 func (*Server) GetTokenBalance(ctx context.Context, in *token_pb.GetTokenBalanceRequest) (*token_pb.GetTokenBalanceResponse, error) {
 	config, err := utils.GetConfig()
 	if err != nil {
-		log.Fatalf("GetNftOwnerShip: Cannot get config: %v", err)
+		return nil, status.Errorf(codes.Internal, "GetTokenBalance: Cannot get config: %v", err)
 	}
 	address := in.GetAddress()
+	if !common.IsHexAddress(address) {
+		return nil, status.Errorf(codes.InvalidArgument, "GetTokenBalance: Invalid address %q", address)
+	}
+	config, err = utils.AtBlock(ctx, config, in.GetAt())
+	if err != nil {
+		return nil, utils.StatusError(err)
+	}
 
-	result := utils.CallViewMethods(config, "balanceOf", big.NewInt(0), common.HexToAddress(address))
+	result, err := utils.CallViewMethods(config, utils.ANIWAR_TOKEN, "balanceOf", big.NewInt(0), common.HexToAddress(address))
+	if err != nil {
+		return nil, utils.StatusError(err)
+	}
 	response := result[0].(*big.Int).String()
-	println(response)
 	return &token_pb.GetTokenBalanceResponse{
 		Balance: response,
 	}, nil
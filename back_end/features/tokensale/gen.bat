@@ -0,0 +1 @@
+start protoc --go_out=. --go-grpc_out=. ./tokensale_pb/tokensale.proto
\ No newline at end of file
@@ -0,0 +1,1117 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        v3.19.1
+// source: tokensale_pb/tokensale.proto
+
+package tokensale_pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetSaleStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetSaleStatusRequest) Reset() {
+	*x = GetSaleStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tokensale_pb_tokensale_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSaleStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSaleStatusRequest) ProtoMessage() {}
+
+func (x *GetSaleStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tokensale_pb_tokensale_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSaleStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetSaleStatusRequest) Descriptor() ([]byte, []int) {
+	return file_tokensale_pb_tokensale_proto_rawDescGZIP(), []int{0}
+}
+
+type GetSaleStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Started bool   `protobuf:"varint,2,opt,name=started,proto3" json:"started,omitempty"`
+	// Unix seconds, 0 until started
+	StartedTime uint64 `protobuf:"varint,3,opt,name=started_time,json=startedTime,proto3" json:"started_time,omitempty"`
+	// Amounts are base 10 strings in the token's smallest unit
+	TotalSold       string `protobuf:"bytes,4,opt,name=total_sold,json=totalSold,proto3" json:"total_sold,omitempty"`
+	InitTokenAmount string `protobuf:"bytes,5,opt,name=init_token_amount,json=initTokenAmount,proto3" json:"init_token_amount,omitempty"`
+	// Payment token units per ANI
+	Price string `protobuf:"bytes,6,opt,name=price,proto3" json:"price,omitempty"`
+	// Payment tokens buyToken accepts
+	TokensAllowed []string `protobuf:"bytes,7,rep,name=tokens_allowed,json=tokensAllowed,proto3" json:"tokens_allowed,omitempty"`
+	SplitDuration uint64   `protobuf:"varint,8,opt,name=split_duration,json=splitDuration,proto3" json:"split_duration,omitempty"`
+	SplitCount    uint64   `protobuf:"varint,9,opt,name=split_count,json=splitCount,proto3" json:"split_count,omitempty"`
+	// ANI held by the sale contract
+	Balance string `protobuf:"bytes,10,opt,name=balance,proto3" json:"balance,omitempty"`
+}
+
+func (x *GetSaleStatusResponse) Reset() {
+	*x = GetSaleStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tokensale_pb_tokensale_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSaleStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSaleStatusResponse) ProtoMessage() {}
+
+func (x *GetSaleStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tokensale_pb_tokensale_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSaleStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetSaleStatusResponse) Descriptor() ([]byte, []int) {
+	return file_tokensale_pb_tokensale_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetSaleStatusResponse) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *GetSaleStatusResponse) GetStarted() bool {
+	if x != nil {
+		return x.Started
+	}
+	return false
+}
+
+func (x *GetSaleStatusResponse) GetStartedTime() uint64 {
+	if x != nil {
+		return x.StartedTime
+	}
+	return 0
+}
+
+func (x *GetSaleStatusResponse) GetTotalSold() string {
+	if x != nil {
+		return x.TotalSold
+	}
+	return ""
+}
+
+func (x *GetSaleStatusResponse) GetInitTokenAmount() string {
+	if x != nil {
+		return x.InitTokenAmount
+	}
+	return ""
+}
+
+func (x *GetSaleStatusResponse) GetPrice() string {
+	if x != nil {
+		return x.Price
+	}
+	return ""
+}
+
+func (x *GetSaleStatusResponse) GetTokensAllowed() []string {
+	if x != nil {
+		return x.TokensAllowed
+	}
+	return nil
+}
+
+func (x *GetSaleStatusResponse) GetSplitDuration() uint64 {
+	if x != nil {
+		return x.SplitDuration
+	}
+	return 0
+}
+
+func (x *GetSaleStatusResponse) GetSplitCount() uint64 {
+	if x != nil {
+		return x.SplitCount
+	}
+	return 0
+}
+
+func (x *GetSaleStatusResponse) GetBalance() string {
+	if x != nil {
+		return x.Balance
+	}
+	return ""
+}
+
+type GetBuyerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Buyer string `protobuf:"bytes,1,opt,name=buyer,proto3" json:"buyer,omitempty"`
+}
+
+func (x *GetBuyerRequest) Reset() {
+	*x = GetBuyerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tokensale_pb_tokensale_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBuyerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBuyerRequest) ProtoMessage() {}
+
+func (x *GetBuyerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tokensale_pb_tokensale_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBuyerRequest.ProtoReflect.Descriptor instead.
+func (*GetBuyerRequest) Descriptor() ([]byte, []int) {
+	return file_tokensale_pb_tokensale_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetBuyerRequest) GetBuyer() string {
+	if x != nil {
+		return x.Buyer
+	}
+	return ""
+}
+
+type GetBuyerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Buyer string `protobuf:"bytes,1,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	// False when the address was never added
+	Initialized bool `protobuf:"varint,2,opt,name=initialized,proto3" json:"initialized,omitempty"`
+	// Amount the buyer may still buy
+	TotalAllowedAmount string `protobuf:"bytes,3,opt,name=total_allowed_amount,json=totalAllowedAmount,proto3" json:"total_allowed_amount,omitempty"`
+	// Amount bought
+	TotalAmount  string `protobuf:"bytes,4,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+	Withdrawn    string `protobuf:"bytes,5,opt,name=withdrawn,proto3" json:"withdrawn,omitempty"`
+	Withdrawable string `protobuf:"bytes,6,opt,name=withdrawable,proto3" json:"withdrawable,omitempty"`
+	// Splits unlocked now, 0 to 12
+	CurrentSplit uint64 `protobuf:"varint,7,opt,name=current_split,json=currentSplit,proto3" json:"current_split,omitempty"`
+}
+
+func (x *GetBuyerResponse) Reset() {
+	*x = GetBuyerResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tokensale_pb_tokensale_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBuyerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBuyerResponse) ProtoMessage() {}
+
+func (x *GetBuyerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tokensale_pb_tokensale_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBuyerResponse.ProtoReflect.Descriptor instead.
+func (*GetBuyerResponse) Descriptor() ([]byte, []int) {
+	return file_tokensale_pb_tokensale_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetBuyerResponse) GetBuyer() string {
+	if x != nil {
+		return x.Buyer
+	}
+	return ""
+}
+
+func (x *GetBuyerResponse) GetInitialized() bool {
+	if x != nil {
+		return x.Initialized
+	}
+	return false
+}
+
+func (x *GetBuyerResponse) GetTotalAllowedAmount() string {
+	if x != nil {
+		return x.TotalAllowedAmount
+	}
+	return ""
+}
+
+func (x *GetBuyerResponse) GetTotalAmount() string {
+	if x != nil {
+		return x.TotalAmount
+	}
+	return ""
+}
+
+func (x *GetBuyerResponse) GetWithdrawn() string {
+	if x != nil {
+		return x.Withdrawn
+	}
+	return ""
+}
+
+func (x *GetBuyerResponse) GetWithdrawable() string {
+	if x != nil {
+		return x.Withdrawable
+	}
+	return ""
+}
+
+func (x *GetBuyerResponse) GetCurrentSplit() uint64 {
+	if x != nil {
+		return x.CurrentSplit
+	}
+	return 0
+}
+
+type GetReleaseCalendarRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Optional
+	Buyer string `protobuf:"bytes,1,opt,name=buyer,proto3" json:"buyer,omitempty"`
+}
+
+func (x *GetReleaseCalendarRequest) Reset() {
+	*x = GetReleaseCalendarRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tokensale_pb_tokensale_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetReleaseCalendarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReleaseCalendarRequest) ProtoMessage() {}
+
+func (x *GetReleaseCalendarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tokensale_pb_tokensale_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReleaseCalendarRequest.ProtoReflect.Descriptor instead.
+func (*GetReleaseCalendarRequest) Descriptor() ([]byte, []int) {
+	return file_tokensale_pb_tokensale_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetReleaseCalendarRequest) GetBuyer() string {
+	if x != nil {
+		return x.Buyer
+	}
+	return ""
+}
+
+type ReleaseSlot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 1 to 12
+	Split uint64 `protobuf:"varint,1,opt,name=split,proto3" json:"split,omitempty"`
+	// Unix seconds the split unlocks at
+	Time uint64 `protobuf:"varint,2,opt,name=time,proto3" json:"time,omitempty"`
+	// Amounts of the buyer, empty without a buyer
+	Amount           string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	CumulativeAmount string `protobuf:"bytes,4,opt,name=cumulative_amount,json=cumulativeAmount,proto3" json:"cumulative_amount,omitempty"`
+	Unlocked         bool   `protobuf:"varint,5,opt,name=unlocked,proto3" json:"unlocked,omitempty"`
+}
+
+func (x *ReleaseSlot) Reset() {
+	*x = ReleaseSlot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tokensale_pb_tokensale_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReleaseSlot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseSlot) ProtoMessage() {}
+
+func (x *ReleaseSlot) ProtoReflect() protoreflect.Message {
+	mi := &file_tokensale_pb_tokensale_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseSlot.ProtoReflect.Descriptor instead.
+func (*ReleaseSlot) Descriptor() ([]byte, []int) {
+	return file_tokensale_pb_tokensale_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ReleaseSlot) GetSplit() uint64 {
+	if x != nil {
+		return x.Split
+	}
+	return 0
+}
+
+func (x *ReleaseSlot) GetTime() uint64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *ReleaseSlot) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *ReleaseSlot) GetCumulativeAmount() string {
+	if x != nil {
+		return x.CumulativeAmount
+	}
+	return ""
+}
+
+func (x *ReleaseSlot) GetUnlocked() bool {
+	if x != nil {
+		return x.Unlocked
+	}
+	return false
+}
+
+type GetReleaseCalendarResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Empty until the sale is started
+	Slots []*ReleaseSlot `protobuf:"bytes,1,rep,name=slots,proto3" json:"slots,omitempty"`
+}
+
+func (x *GetReleaseCalendarResponse) Reset() {
+	*x = GetReleaseCalendarResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tokensale_pb_tokensale_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetReleaseCalendarResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReleaseCalendarResponse) ProtoMessage() {}
+
+func (x *GetReleaseCalendarResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tokensale_pb_tokensale_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReleaseCalendarResponse.ProtoReflect.Descriptor instead.
+func (*GetReleaseCalendarResponse) Descriptor() ([]byte, []int) {
+	return file_tokensale_pb_tokensale_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetReleaseCalendarResponse) GetSlots() []*ReleaseSlot {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+type AddBuyersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Rows of address,amount with the amount in base 10. A first row starting with "address" is a header
+	Csv []byte `protobuf:"bytes,1,opt,name=csv,proto3" json:"csv,omitempty"`
+}
+
+func (x *AddBuyersRequest) Reset() {
+	*x = AddBuyersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tokensale_pb_tokensale_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddBuyersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddBuyersRequest) ProtoMessage() {}
+
+func (x *AddBuyersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tokensale_pb_tokensale_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddBuyersRequest.ProtoReflect.Descriptor instead.
+func (*AddBuyersRequest) Descriptor() ([]byte, []int) {
+	return file_tokensale_pb_tokensale_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *AddBuyersRequest) GetCsv() []byte {
+	if x != nil {
+		return x.Csv
+	}
+	return nil
+}
+
+type AddBuyerResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 1 based line of the row in the file
+	Line   uint32 `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`
+	Buyer  string `protobuf:"bytes,2,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	Amount string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Empty when the row was not sent
+	TxHash string `protobuf:"bytes,4,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	Error  string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *AddBuyerResult) Reset() {
+	*x = AddBuyerResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tokensale_pb_tokensale_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddBuyerResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddBuyerResult) ProtoMessage() {}
+
+func (x *AddBuyerResult) ProtoReflect() protoreflect.Message {
+	mi := &file_tokensale_pb_tokensale_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddBuyerResult.ProtoReflect.Descriptor instead.
+func (*AddBuyerResult) Descriptor() ([]byte, []int) {
+	return file_tokensale_pb_tokensale_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *AddBuyerResult) GetLine() uint32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *AddBuyerResult) GetBuyer() string {
+	if x != nil {
+		return x.Buyer
+	}
+	return ""
+}
+
+func (x *AddBuyerResult) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *AddBuyerResult) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+func (x *AddBuyerResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type AddBuyersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results []*AddBuyerResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *AddBuyersResponse) Reset() {
+	*x = AddBuyersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tokensale_pb_tokensale_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddBuyersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddBuyersResponse) ProtoMessage() {}
+
+func (x *AddBuyersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tokensale_pb_tokensale_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddBuyersResponse.ProtoReflect.Descriptor instead.
+func (*AddBuyersResponse) Descriptor() ([]byte, []int) {
+	return file_tokensale_pb_tokensale_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *AddBuyersResponse) GetResults() []*AddBuyerResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type StartSaleScheduleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Unix seconds the first split unlocks at
+	StartTime uint64 `protobuf:"varint,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+}
+
+func (x *StartSaleScheduleRequest) Reset() {
+	*x = StartSaleScheduleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tokensale_pb_tokensale_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartSaleScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartSaleScheduleRequest) ProtoMessage() {}
+
+func (x *StartSaleScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tokensale_pb_tokensale_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartSaleScheduleRequest.ProtoReflect.Descriptor instead.
+func (*StartSaleScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_tokensale_pb_tokensale_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *StartSaleScheduleRequest) GetStartTime() uint64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+// The transaction is sent, its outcome is available from TransactionService
+type SaleTxResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TxHash string `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+}
+
+func (x *SaleTxResponse) Reset() {
+	*x = SaleTxResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tokensale_pb_tokensale_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SaleTxResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaleTxResponse) ProtoMessage() {}
+
+func (x *SaleTxResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tokensale_pb_tokensale_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaleTxResponse.ProtoReflect.Descriptor instead.
+func (*SaleTxResponse) Descriptor() ([]byte, []int) {
+	return file_tokensale_pb_tokensale_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SaleTxResponse) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+var File_tokensale_pb_tokensale_proto protoreflect.FileDescriptor
+
+var file_tokensale_pb_tokensale_proto_rawDesc = []byte{
+	0x0a, 0x1c, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x61, 0x6c, 0x65, 0x5f, 0x70, 0x62, 0x2f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x61, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x61, 0x6c, 0x65, 0x5f, 0x70, 0x62, 0x22, 0x16, 0x0a, 0x14,
+	0x47, 0x65, 0x74, 0x53, 0x61, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0xd8, 0x02, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x53, 0x61, 0x6c, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65,
+	0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73,
+	0x6f, 0x6c, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x53, 0x6f, 0x6c, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x69, 0x6e, 0x69, 0x74, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0f, 0x69, 0x6e, 0x69, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73,
+	0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x12, 0x25, 0x0a,
+	0x0e, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x70, 0x6c, 0x69, 0x74,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x22,
+	0x27, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x42, 0x75, 0x79, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x75, 0x79, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x62, 0x75, 0x79, 0x65, 0x72, 0x22, 0x86, 0x02, 0x0a, 0x10, 0x47, 0x65, 0x74,
+	0x42, 0x75, 0x79, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x62, 0x75, 0x79, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x62, 0x75,
+	0x79, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a,
+	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61,
+	0x6c, 0x69, 0x7a, 0x65, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x61,
+	0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x12, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65,
+	0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x77, 0x69,
+	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x77,
+	0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x77, 0x69, 0x74, 0x68,
+	0x64, 0x72, 0x61, 0x77, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x23, 0x0a, 0x0d,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x70, 0x6c, 0x69,
+	0x74, 0x22, 0x31, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x43,
+	0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x62, 0x75, 0x79, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x62,
+	0x75, 0x79, 0x65, 0x72, 0x22, 0x98, 0x01, 0x0a, 0x0b, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x53, 0x6c, 0x6f, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x05, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61,
+	0x74, 0x69, 0x76, 0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x10, 0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x41, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x22,
+	0x4d, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x43, 0x61, 0x6c,
+	0x65, 0x6e, 0x64, 0x61, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a,
+	0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x61, 0x6c, 0x65, 0x5f, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x6c, 0x65,
+	0x61, 0x73, 0x65, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x22, 0x24,
+	0x0a, 0x10, 0x41, 0x64, 0x64, 0x42, 0x75, 0x79, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x73, 0x76, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x03, 0x63, 0x73, 0x76, 0x22, 0x81, 0x01, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x42, 0x75, 0x79, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x62,
+	0x75, 0x79, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x62, 0x75, 0x79, 0x65,
+	0x72, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x78, 0x5f,
+	0x68, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x78, 0x48, 0x61,
+	0x73, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x4b, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x42,
+	0x75, 0x79, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a,
+	0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x61, 0x6c, 0x65, 0x5f, 0x70, 0x62, 0x2e, 0x41, 0x64,
+	0x64, 0x42, 0x75, 0x79, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x39, 0x0a, 0x18, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x61,
+	0x6c, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65,
+	0x22, 0x29, 0x0a, 0x0e, 0x53, 0x61, 0x6c, 0x65, 0x54, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x32, 0xc9, 0x03, 0x0a, 0x10,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x53, 0x61, 0x6c, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x58, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x53, 0x61, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x22, 0x2e, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x61, 0x6c, 0x65, 0x5f, 0x70, 0x62,
+	0x2e, 0x47, 0x65, 0x74, 0x53, 0x61, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x61, 0x6c,
+	0x65, 0x5f, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x61, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x08, 0x47, 0x65,
+	0x74, 0x42, 0x75, 0x79, 0x65, 0x72, 0x12, 0x1d, 0x2e, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x61,
+	0x6c, 0x65, 0x5f, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x75, 0x79, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x61, 0x6c,
+	0x65, 0x5f, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x75, 0x79, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x67, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x65,
+	0x61, 0x73, 0x65, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x12, 0x27, 0x2e, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x73, 0x61, 0x6c, 0x65, 0x5f, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x61, 0x6c, 0x65,
+	0x5f, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x43, 0x61,
+	0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c,
+	0x0a, 0x09, 0x41, 0x64, 0x64, 0x42, 0x75, 0x79, 0x65, 0x72, 0x73, 0x12, 0x1e, 0x2e, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x73, 0x61, 0x6c, 0x65, 0x5f, 0x70, 0x62, 0x2e, 0x41, 0x64, 0x64, 0x42, 0x75,
+	0x79, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x73, 0x61, 0x6c, 0x65, 0x5f, 0x70, 0x62, 0x2e, 0x41, 0x64, 0x64, 0x42, 0x75,
+	0x79, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x11,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x61, 0x6c, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x12, 0x26, 0x2e, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x61, 0x6c, 0x65, 0x5f, 0x70, 0x62,
+	0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x61, 0x6c, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x73, 0x61, 0x6c, 0x65, 0x5f, 0x70, 0x62, 0x2e, 0x53, 0x61, 0x6c, 0x65, 0x54, 0x78, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x0f, 0x5a, 0x0d, 0x2f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x73, 0x61, 0x6c, 0x65, 0x5f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_tokensale_pb_tokensale_proto_rawDescOnce sync.Once
+	file_tokensale_pb_tokensale_proto_rawDescData = file_tokensale_pb_tokensale_proto_rawDesc
+)
+
+func file_tokensale_pb_tokensale_proto_rawDescGZIP() []byte {
+	file_tokensale_pb_tokensale_proto_rawDescOnce.Do(func() {
+		file_tokensale_pb_tokensale_proto_rawDescData = protoimpl.X.CompressGZIP(file_tokensale_pb_tokensale_proto_rawDescData)
+	})
+	return file_tokensale_pb_tokensale_proto_rawDescData
+}
+
+var file_tokensale_pb_tokensale_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_tokensale_pb_tokensale_proto_goTypes = []interface{}{
+	(*GetSaleStatusRequest)(nil),       // 0: tokensale_pb.GetSaleStatusRequest
+	(*GetSaleStatusResponse)(nil),      // 1: tokensale_pb.GetSaleStatusResponse
+	(*GetBuyerRequest)(nil),            // 2: tokensale_pb.GetBuyerRequest
+	(*GetBuyerResponse)(nil),           // 3: tokensale_pb.GetBuyerResponse
+	(*GetReleaseCalendarRequest)(nil),  // 4: tokensale_pb.GetReleaseCalendarRequest
+	(*ReleaseSlot)(nil),                // 5: tokensale_pb.ReleaseSlot
+	(*GetReleaseCalendarResponse)(nil), // 6: tokensale_pb.GetReleaseCalendarResponse
+	(*AddBuyersRequest)(nil),           // 7: tokensale_pb.AddBuyersRequest
+	(*AddBuyerResult)(nil),             // 8: tokensale_pb.AddBuyerResult
+	(*AddBuyersResponse)(nil),          // 9: tokensale_pb.AddBuyersResponse
+	(*StartSaleScheduleRequest)(nil),   // 10: tokensale_pb.StartSaleScheduleRequest
+	(*SaleTxResponse)(nil),             // 11: tokensale_pb.SaleTxResponse
+}
+var file_tokensale_pb_tokensale_proto_depIdxs = []int32{
+	5,  // 0: tokensale_pb.GetReleaseCalendarResponse.slots:type_name -> tokensale_pb.ReleaseSlot
+	8,  // 1: tokensale_pb.AddBuyersResponse.results:type_name -> tokensale_pb.AddBuyerResult
+	0,  // 2: tokensale_pb.TokenSaleService.GetSaleStatus:input_type -> tokensale_pb.GetSaleStatusRequest
+	2,  // 3: tokensale_pb.TokenSaleService.GetBuyer:input_type -> tokensale_pb.GetBuyerRequest
+	4,  // 4: tokensale_pb.TokenSaleService.GetReleaseCalendar:input_type -> tokensale_pb.GetReleaseCalendarRequest
+	7,  // 5: tokensale_pb.TokenSaleService.AddBuyers:input_type -> tokensale_pb.AddBuyersRequest
+	10, // 6: tokensale_pb.TokenSaleService.StartSaleSchedule:input_type -> tokensale_pb.StartSaleScheduleRequest
+	1,  // 7: tokensale_pb.TokenSaleService.GetSaleStatus:output_type -> tokensale_pb.GetSaleStatusResponse
+	3,  // 8: tokensale_pb.TokenSaleService.GetBuyer:output_type -> tokensale_pb.GetBuyerResponse
+	6,  // 9: tokensale_pb.TokenSaleService.GetReleaseCalendar:output_type -> tokensale_pb.GetReleaseCalendarResponse
+	9,  // 10: tokensale_pb.TokenSaleService.AddBuyers:output_type -> tokensale_pb.AddBuyersResponse
+	11, // 11: tokensale_pb.TokenSaleService.StartSaleSchedule:output_type -> tokensale_pb.SaleTxResponse
+	7,  // [7:12] is the sub-list for method output_type
+	2,  // [2:7] is the sub-list for method input_type
+	2,  // [2:2] is the sub-list for extension type_name
+	2,  // [2:2] is the sub-list for extension extendee
+	0,  // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_tokensale_pb_tokensale_proto_init() }
+func file_tokensale_pb_tokensale_proto_init() {
+	if File_tokensale_pb_tokensale_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_tokensale_pb_tokensale_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSaleStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tokensale_pb_tokensale_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSaleStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tokensale_pb_tokensale_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBuyerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tokensale_pb_tokensale_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBuyerResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tokensale_pb_tokensale_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetReleaseCalendarRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tokensale_pb_tokensale_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReleaseSlot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tokensale_pb_tokensale_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetReleaseCalendarResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tokensale_pb_tokensale_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddBuyersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tokensale_pb_tokensale_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddBuyerResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tokensale_pb_tokensale_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddBuyersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tokensale_pb_tokensale_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartSaleScheduleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tokensale_pb_tokensale_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SaleTxResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_tokensale_pb_tokensale_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   12,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_tokensale_pb_tokensale_proto_goTypes,
+		DependencyIndexes: file_tokensale_pb_tokensale_proto_depIdxs,
+		MessageInfos:      file_tokensale_pb_tokensale_proto_msgTypes,
+	}.Build()
+	File_tokensale_pb_tokensale_proto = out.File
+	file_tokensale_pb_tokensale_proto_rawDesc = nil
+	file_tokensale_pb_tokensale_proto_goTypes = nil
+	file_tokensale_pb_tokensale_proto_depIdxs = nil
+}
@@ -0,0 +1,255 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package tokensale_pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// TokenSaleServiceClient is the client API for TokenSaleService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TokenSaleServiceClient interface {
+	// Returns the AniwarTokenSale settings and progress
+	GetSaleStatus(ctx context.Context, in *GetSaleStatusRequest, opts ...grpc.CallOption) (*GetSaleStatusResponse, error)
+	// Returns the allocation of a buyer and what it can release now
+	GetBuyer(ctx context.Context, in *GetBuyerRequest, opts ...grpc.CallOption) (*GetBuyerResponse, error)
+	// Returns the 12 release dates, with the amounts of a buyer when one is given
+	GetReleaseCalendar(ctx context.Context, in *GetReleaseCalendarRequest, opts ...grpc.CallOption) (*GetReleaseCalendarResponse, error)
+	// Admin methods, sent from the signer routed to AniwarTokenSale
+	// Calls addBuyer for every row of a CSV file
+	AddBuyers(ctx context.Context, in *AddBuyersRequest, opts ...grpc.CallOption) (*AddBuyersResponse, error)
+	StartSaleSchedule(ctx context.Context, in *StartSaleScheduleRequest, opts ...grpc.CallOption) (*SaleTxResponse, error)
+}
+
+type tokenSaleServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTokenSaleServiceClient(cc grpc.ClientConnInterface) TokenSaleServiceClient {
+	return &tokenSaleServiceClient{cc}
+}
+
+func (c *tokenSaleServiceClient) GetSaleStatus(ctx context.Context, in *GetSaleStatusRequest, opts ...grpc.CallOption) (*GetSaleStatusResponse, error) {
+	out := new(GetSaleStatusResponse)
+	err := c.cc.Invoke(ctx, "/tokensale_pb.TokenSaleService/GetSaleStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tokenSaleServiceClient) GetBuyer(ctx context.Context, in *GetBuyerRequest, opts ...grpc.CallOption) (*GetBuyerResponse, error) {
+	out := new(GetBuyerResponse)
+	err := c.cc.Invoke(ctx, "/tokensale_pb.TokenSaleService/GetBuyer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tokenSaleServiceClient) GetReleaseCalendar(ctx context.Context, in *GetReleaseCalendarRequest, opts ...grpc.CallOption) (*GetReleaseCalendarResponse, error) {
+	out := new(GetReleaseCalendarResponse)
+	err := c.cc.Invoke(ctx, "/tokensale_pb.TokenSaleService/GetReleaseCalendar", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tokenSaleServiceClient) AddBuyers(ctx context.Context, in *AddBuyersRequest, opts ...grpc.CallOption) (*AddBuyersResponse, error) {
+	out := new(AddBuyersResponse)
+	err := c.cc.Invoke(ctx, "/tokensale_pb.TokenSaleService/AddBuyers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tokenSaleServiceClient) StartSaleSchedule(ctx context.Context, in *StartSaleScheduleRequest, opts ...grpc.CallOption) (*SaleTxResponse, error) {
+	out := new(SaleTxResponse)
+	err := c.cc.Invoke(ctx, "/tokensale_pb.TokenSaleService/StartSaleSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TokenSaleServiceServer is the server API for TokenSaleService service.
+// All implementations must embed UnimplementedTokenSaleServiceServer
+// for forward compatibility
+type TokenSaleServiceServer interface {
+	// Returns the AniwarTokenSale settings and progress
+	GetSaleStatus(context.Context, *GetSaleStatusRequest) (*GetSaleStatusResponse, error)
+	// Returns the allocation of a buyer and what it can release now
+	GetBuyer(context.Context, *GetBuyerRequest) (*GetBuyerResponse, error)
+	// Returns the 12 release dates, with the amounts of a buyer when one is given
+	GetReleaseCalendar(context.Context, *GetReleaseCalendarRequest) (*GetReleaseCalendarResponse, error)
+	// Admin methods, sent from the signer routed to AniwarTokenSale
+	// Calls addBuyer for every row of a CSV file
+	AddBuyers(context.Context, *AddBuyersRequest) (*AddBuyersResponse, error)
+	StartSaleSchedule(context.Context, *StartSaleScheduleRequest) (*SaleTxResponse, error)
+	mustEmbedUnimplementedTokenSaleServiceServer()
+}
+
+// UnimplementedTokenSaleServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedTokenSaleServiceServer struct {
+}
+
+func (UnimplementedTokenSaleServiceServer) GetSaleStatus(context.Context, *GetSaleStatusRequest) (*GetSaleStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSaleStatus not implemented")
+}
+func (UnimplementedTokenSaleServiceServer) GetBuyer(context.Context, *GetBuyerRequest) (*GetBuyerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBuyer not implemented")
+}
+func (UnimplementedTokenSaleServiceServer) GetReleaseCalendar(context.Context, *GetReleaseCalendarRequest) (*GetReleaseCalendarResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReleaseCalendar not implemented")
+}
+func (UnimplementedTokenSaleServiceServer) AddBuyers(context.Context, *AddBuyersRequest) (*AddBuyersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddBuyers not implemented")
+}
+func (UnimplementedTokenSaleServiceServer) StartSaleSchedule(context.Context, *StartSaleScheduleRequest) (*SaleTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartSaleSchedule not implemented")
+}
+func (UnimplementedTokenSaleServiceServer) mustEmbedUnimplementedTokenSaleServiceServer() {}
+
+// UnsafeTokenSaleServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TokenSaleServiceServer will
+// result in compilation errors.
+type UnsafeTokenSaleServiceServer interface {
+	mustEmbedUnimplementedTokenSaleServiceServer()
+}
+
+func RegisterTokenSaleServiceServer(s grpc.ServiceRegistrar, srv TokenSaleServiceServer) {
+	s.RegisterService(&TokenSaleService_ServiceDesc, srv)
+}
+
+func _TokenSaleService_GetSaleStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSaleStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TokenSaleServiceServer).GetSaleStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokensale_pb.TokenSaleService/GetSaleStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TokenSaleServiceServer).GetSaleStatus(ctx, req.(*GetSaleStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TokenSaleService_GetBuyer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBuyerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TokenSaleServiceServer).GetBuyer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokensale_pb.TokenSaleService/GetBuyer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TokenSaleServiceServer).GetBuyer(ctx, req.(*GetBuyerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TokenSaleService_GetReleaseCalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReleaseCalendarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TokenSaleServiceServer).GetReleaseCalendar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokensale_pb.TokenSaleService/GetReleaseCalendar",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TokenSaleServiceServer).GetReleaseCalendar(ctx, req.(*GetReleaseCalendarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TokenSaleService_AddBuyers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddBuyersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TokenSaleServiceServer).AddBuyers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokensale_pb.TokenSaleService/AddBuyers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TokenSaleServiceServer).AddBuyers(ctx, req.(*AddBuyersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TokenSaleService_StartSaleSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartSaleScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TokenSaleServiceServer).StartSaleSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tokensale_pb.TokenSaleService/StartSaleSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TokenSaleServiceServer).StartSaleSchedule(ctx, req.(*StartSaleScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TokenSaleService_ServiceDesc is the grpc.ServiceDesc for TokenSaleService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TokenSaleService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "tokensale_pb.TokenSaleService",
+	HandlerType: (*TokenSaleServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetSaleStatus",
+			Handler:    _TokenSaleService_GetSaleStatus_Handler,
+		},
+		{
+			MethodName: "GetBuyer",
+			Handler:    _TokenSaleService_GetBuyer_Handler,
+		},
+		{
+			MethodName: "GetReleaseCalendar",
+			Handler:    _TokenSaleService_GetReleaseCalendar_Handler,
+		},
+		{
+			MethodName: "AddBuyers",
+			Handler:    _TokenSaleService_AddBuyers_Handler,
+		},
+		{
+			MethodName: "StartSaleSchedule",
+			Handler:    _TokenSaleService_StartSaleSchedule_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "tokensale_pb/tokensale.proto",
+}
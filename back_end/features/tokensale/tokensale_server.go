@@ -0,0 +1,295 @@
+package tokensale
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/mineloop99/new-token/back_end/features/tokensale/tokensale_pb"
+	"github.com/mineloop99/new-token/back_end/utils"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Rows of a single AddBuyers upload, each row is one transaction
+const MAX_CSV_ROWS = 500
+
+// tokensAllowed is set once by the constructor and has no length getter,
+// the first indexes are read in the status batch until one reverts
+const MAX_TOKENS_ALLOWED = 20
+
+type Server struct {
+	tokensale_pb.UnimplementedTokenSaleServiceServer
+}
+
+func RewardRegister(s grpc.ServiceRegistrar) {
+	tokensale_pb.RegisterTokenSaleServiceServer(s, &Server{})
+}
+
+func (*Server) GetSaleStatus(ctx context.Context, in *tokensale_pb.GetSaleStatusRequest) (*tokensale_pb.GetSaleStatusResponse, error) {
+	config, err := utils.GetConfig()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "GetSaleStatus: Cannot get config: %v", err)
+	}
+	contract, err := config.GetContract(utils.ANIWAR_TOKEN_SALE)
+	if err != nil {
+		return nil, utils.StatusError(err)
+	}
+	calls := []*utils.ViewCall{
+		{ContractName: utils.ANIWAR_TOKEN_SALE, MethodName: "isStarted"},
+		{ContractName: utils.ANIWAR_TOKEN_SALE, MethodName: "startedTime"},
+		{ContractName: utils.ANIWAR_TOKEN_SALE, MethodName: "totalSold"},
+		{ContractName: utils.ANIWAR_TOKEN_SALE, MethodName: "initTokenAmount"},
+		{ContractName: utils.ANIWAR_TOKEN_SALE, MethodName: "price"},
+		{ContractName: utils.ANIWAR_TOKEN_SALE, MethodName: "splitDuration"},
+		{ContractName: utils.ANIWAR_TOKEN_SALE, MethodName: "splitCount"},
+		{ContractName: utils.ANIWAR_TOKEN_SALE, MethodName: "getBalance"},
+	}
+	for i := 0; i < MAX_TOKENS_ALLOWED; i++ {
+		calls = append(calls, &utils.ViewCall{ContractName: utils.ANIWAR_TOKEN_SALE, MethodName: "tokensAllowed", Args: []interface{}{big.NewInt(int64(i))}})
+	}
+	err = utils.BatchCallViewMethods(config, calls)
+	if err != nil {
+		return nil, utils.StatusError(err)
+	}
+	for _, call := range calls[:8] {
+		if call.Err != nil {
+			return nil, utils.StatusError(call.Err)
+		}
+	}
+	var tokensAllowed []string
+	for _, call := range calls[8:] {
+		var revertErr *utils.RevertError
+		if errors.As(call.Err, &revertErr) {
+			// Past the end of the array
+			break
+		}
+		if call.Err != nil {
+			return nil, utils.StatusError(call.Err)
+		}
+		tokensAllowed = append(tokensAllowed, call.Result[0].(common.Address).Hex())
+	}
+
+	return &tokensale_pb.GetSaleStatusResponse{
+		Address:         contract.Address.Hex(),
+		Started:         calls[0].Result[0].(bool),
+		StartedTime:     calls[1].Result[0].(*big.Int).Uint64(),
+		TotalSold:       calls[2].Result[0].(*big.Int).String(),
+		InitTokenAmount: calls[3].Result[0].(*big.Int).String(),
+		Price:           calls[4].Result[0].(*big.Int).String(),
+		TokensAllowed:   tokensAllowed,
+		SplitDuration:   calls[5].Result[0].(*big.Int).Uint64(),
+		SplitCount:      calls[6].Result[0].(*big.Int).Uint64(),
+		Balance:         calls[7].Result[0].(*big.Int).String(),
+	}, nil
+}
+
+func (*Server) GetBuyer(ctx context.Context, in *tokensale_pb.GetBuyerRequest) (*tokensale_pb.GetBuyerResponse, error) {
+	config, err := utils.GetConfig()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "GetBuyer: Cannot get config: %v", err)
+	}
+	if !common.IsHexAddress(in.GetBuyer()) {
+		return nil, status.Errorf(codes.InvalidArgument, "GetBuyer: Invalid address %q", in.GetBuyer())
+	}
+	buyer := common.HexToAddress(in.GetBuyer())
+	// The contract reads block.timestamp, use the latest block rather than the server clock
+	header, err := config.Client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, utils.StatusError(&utils.RpcError{Method: "eth_getBlockByNumber", Err: err})
+	}
+	calls := []*utils.ViewCall{
+		{ContractName: utils.ANIWAR_TOKEN_SALE, MethodName: "buyers", Args: []interface{}{buyer}},
+		{ContractName: utils.ANIWAR_TOKEN_SALE, MethodName: "calculateWithdrawableAmount", Args: []interface{}{buyer}},
+		{ContractName: utils.ANIWAR_TOKEN_SALE, MethodName: "getSplitByTime", Args: []interface{}{new(big.Int).SetUint64(header.Time)}},
+	}
+	err = utils.BatchCallViewMethods(config, calls)
+	if err != nil {
+		return nil, utils.StatusError(err)
+	}
+	for _, call := range calls {
+		if call.Err != nil {
+			return nil, utils.StatusError(call.Err)
+		}
+	}
+	// (totalAllowedAmount, totalAmount, amountHasBeenWithdrawn, initialized)
+	result := calls[0].Result
+	return &tokensale_pb.GetBuyerResponse{
+		Buyer:              buyer.Hex(),
+		Initialized:        result[3].(bool),
+		TotalAllowedAmount: result[0].(*big.Int).String(),
+		TotalAmount:        result[1].(*big.Int).String(),
+		Withdrawn:          result[2].(*big.Int).String(),
+		Withdrawable:       calls[1].Result[0].(*big.Int).String(),
+		CurrentSplit:       calls[2].Result[0].(*big.Int).Uint64(),
+	}, nil
+}
+
+func (*Server) GetReleaseCalendar(ctx context.Context, in *tokensale_pb.GetReleaseCalendarRequest) (*tokensale_pb.GetReleaseCalendarResponse, error) {
+	config, err := utils.GetConfig()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "GetReleaseCalendar: Cannot get config: %v", err)
+	}
+	var buyer *common.Address
+	if in.GetBuyer() != "" {
+		if !common.IsHexAddress(in.GetBuyer()) {
+			return nil, status.Errorf(codes.InvalidArgument, "GetReleaseCalendar: Invalid address %q", in.GetBuyer())
+		}
+		address := common.HexToAddress(in.GetBuyer())
+		buyer = &address
+	}
+	header, err := config.Client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, utils.StatusError(&utils.RpcError{Method: "eth_getBlockByNumber", Err: err})
+	}
+
+	calls := []*utils.ViewCall{
+		{ContractName: utils.ANIWAR_TOKEN_SALE, MethodName: "isStarted"},
+		{ContractName: utils.ANIWAR_TOKEN_SALE, MethodName: "getTimes"},
+		{ContractName: utils.ANIWAR_TOKEN_SALE, MethodName: "getSplitByTime", Args: []interface{}{new(big.Int).SetUint64(header.Time)}},
+	}
+	if buyer != nil {
+		calls = append(calls, &utils.ViewCall{ContractName: utils.ANIWAR_TOKEN_SALE, MethodName: "buyers", Args: []interface{}{*buyer}})
+	}
+	err = utils.BatchCallViewMethods(config, calls)
+	if err != nil {
+		return nil, utils.StatusError(err)
+	}
+	for _, call := range calls {
+		if call.Err != nil {
+			return nil, utils.StatusError(call.Err)
+		}
+	}
+	if !calls[0].Result[0].(bool) {
+		return &tokensale_pb.GetReleaseCalendarResponse{}, nil
+	}
+	times := calls[1].Result[0].([12]*big.Int)
+	currentSplit := calls[2].Result[0].(*big.Int).Uint64()
+
+	// calculateWithdrawableAmount unlocks totalAmount * split / splitCount at times[split - 1]
+	splitCount := big.NewInt(int64(len(times)))
+	var totalAmount *big.Int
+	if buyer != nil {
+		totalAmount = calls[3].Result[1].(*big.Int)
+	}
+	slots := make([]*tokensale_pb.ReleaseSlot, 0, len(times))
+	previous := big.NewInt(0)
+	for i, time := range times {
+		split := uint64(i + 1)
+		slot := &tokensale_pb.ReleaseSlot{
+			Split:    split,
+			Time:     time.Uint64(),
+			Unlocked: split <= currentSplit,
+		}
+		if totalAmount != nil {
+			cumulative := new(big.Int).Mul(totalAmount, new(big.Int).SetUint64(split))
+			cumulative.Div(cumulative, splitCount)
+			slot.Amount = new(big.Int).Sub(cumulative, previous).String()
+			slot.CumulativeAmount = cumulative.String()
+			previous = cumulative
+		}
+		slots = append(slots, slot)
+	}
+	return &tokensale_pb.GetReleaseCalendarResponse{Slots: slots}, nil
+}
+
+func (*Server) AddBuyers(ctx context.Context, in *tokensale_pb.AddBuyersRequest) (*tokensale_pb.AddBuyersResponse, error) {
+	config, err := utils.GetConfig()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "AddBuyers: Cannot get config: %v", err)
+	}
+	// The whole file is checked before anything is sent
+	rows, err := parseBuyersCsv(in.GetCsv())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "AddBuyers: %v", err)
+	}
+
+	results := make([]*tokensale_pb.AddBuyerResult, 0, len(rows))
+	for _, row := range rows {
+		result := &tokensale_pb.AddBuyerResult{
+			Line:   row.line,
+			Buyer:  row.buyer.Hex(),
+			Amount: row.amount.String(),
+		}
+		if ctx.Err() != nil {
+			result.Error = ctx.Err().Error()
+		} else if tx, err := utils.CallMethods(config, utils.ANIWAR_TOKEN_SALE, "addBuyer", big.NewInt(0), row.buyer, row.amount); err != nil {
+			result.Error = err.Error()
+		} else {
+			result.TxHash = tx.Hash().Hex()
+		}
+		results = append(results, result)
+	}
+	return &tokensale_pb.AddBuyersResponse{Results: results}, nil
+}
+
+func (*Server) StartSaleSchedule(ctx context.Context, in *tokensale_pb.StartSaleScheduleRequest) (*tokensale_pb.SaleTxResponse, error) {
+	config, err := utils.GetConfig()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "StartSaleSchedule: Cannot get config: %v", err)
+	}
+	if in.GetStartTime() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "StartSaleSchedule: start_time is required")
+	}
+	tx, err := utils.CallMethods(config, utils.ANIWAR_TOKEN_SALE, "startSaleSchedule", big.NewInt(0), new(big.Int).SetUint64(in.GetStartTime()))
+	if err != nil {
+		return nil, utils.StatusError(err)
+	}
+	return &tokensale_pb.SaleTxResponse{TxHash: tx.Hash().Hex()}, nil
+}
+
+type buyerRow struct {
+	line   uint32
+	buyer  common.Address
+	amount *big.Int
+}
+
+// parseBuyersCsv reads address,amount rows. addBuyer adds to the allowance, so a buyer listed twice is refused
+func parseBuyersCsv(data []byte) ([]buyerRow, error) {
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.FieldsPerRecord = 2
+	reader.TrimLeadingSpace = true
+	var rows []buyerRow
+	lines := make(map[common.Address]uint32)
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		line, _ := reader.FieldPos(0)
+		if line == 1 && strings.EqualFold(strings.TrimSpace(record[0]), "address") {
+			continue
+		}
+		address := strings.TrimSpace(record[0])
+		if !common.IsHexAddress(address) {
+			return nil, fmt.Errorf("line %d: Invalid address %q", line, address)
+		}
+		buyer := common.HexToAddress(address)
+		amount, ok := new(big.Int).SetString(strings.TrimSpace(record[1]), 10)
+		if !ok || amount.Sign() <= 0 {
+			return nil, fmt.Errorf("line %d: Invalid amount %q", line, record[1])
+		}
+		if previous, ok := lines[buyer]; ok {
+			return nil, fmt.Errorf("line %d: %s is already on line %d", line, buyer.Hex(), previous)
+		}
+		lines[buyer] = uint32(line)
+		rows = append(rows, buyerRow{line: uint32(line), buyer: buyer, amount: amount})
+		if len(rows) > MAX_CSV_ROWS {
+			return nil, fmt.Errorf("more than %d rows", MAX_CSV_ROWS)
+		}
+	}
+	if len(rows) == 0 {
+		return nil, errors.New("no buyer rows")
+	}
+	return rows, nil
+}
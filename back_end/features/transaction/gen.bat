@@ -0,0 +1 @@
+start protoc --go_out=. --go-grpc_out=. ./transaction_pb/transaction.proto
\ No newline at end of file
@@ -0,0 +1,61 @@
+package transaction
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/mineloop99/new-token/back_end/features/transaction/transaction_pb"
+	"github.com/mineloop99/new-token/back_end/utils"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type Server struct {
+	transaction_pb.UnimplementedTransactionServiceServer
+}
+
+func RewardRegister(s grpc.ServiceRegistrar) {
+	transaction_pb.RegisterTransactionServiceServer(s, &Server{})
+}
+
+func (*Server) GetTransactionStatus(ctx context.Context, in *transaction_pb.GetTransactionStatusRequest) (*transaction_pb.GetTransactionStatusResponse, error) {
+	config, err := utils.GetConfig()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "GetTransactionStatus: Cannot get config: %v", err)
+	}
+	hash, err := parseHash(in.GetTxHash())
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := config.Tracker.Status(ctx, hash)
+	if err != nil {
+		return nil, utils.StatusError(err)
+	}
+	response := &transaction_pb.GetTransactionStatusResponse{
+		TxHash: hash.Hex(),
+		// utils.TxStatus follows the proto enum order
+		Status:                transaction_pb.TransactionStatus(state.Status),
+		Confirmations:         state.Confirmations,
+		RequiredConfirmations: state.RequiredConfirmations,
+	}
+	if state.Receipt != nil {
+		response.BlockNumber = state.Receipt.BlockNumber.Uint64()
+		response.BlockHash = state.Receipt.BlockHash.Hex()
+		response.GasUsed = state.Receipt.GasUsed
+	}
+	if state.RevertErr != nil {
+		response.RevertReason = state.RevertErr.Reason
+	}
+	return response, nil
+}
+
+func parseHash(hash string) (common.Hash, error) {
+	bytes, err := hexutil.Decode(hash)
+	if err != nil || len(bytes) != common.HashLength {
+		return common.Hash{}, status.Errorf(codes.InvalidArgument, "Invalid transaction hash %q", hash)
+	}
+	return common.BytesToHash(bytes), nil
+}
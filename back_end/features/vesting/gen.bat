@@ -0,0 +1 @@
+start protoc -I . -I .. --go_out=. --go-grpc_out=. ./vesting_pb/vesting.proto
\ No newline at end of file
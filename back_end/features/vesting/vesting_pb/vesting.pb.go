@@ -0,0 +1,684 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        v3.19.1
+// source: vesting_pb/vesting.proto
+
+package vesting_pb
+
+import (
+	block_pb "github.com/mineloop99/new-token/back_end/features/block/block_pb"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type VestingContract int32
+
+const (
+	VestingContract_UNSPECIFIED VestingContract = 0
+	// AniwarVesting
+	VestingContract_VESTING_V1 VestingContract = 1
+	// AniwarVestingV2
+	VestingContract_VESTING_V2 VestingContract = 2
+)
+
+// Enum value maps for VestingContract.
+var (
+	VestingContract_name = map[int32]string{
+		0: "UNSPECIFIED",
+		1: "VESTING_V1",
+		2: "VESTING_V2",
+	}
+	VestingContract_value = map[string]int32{
+		"UNSPECIFIED": 0,
+		"VESTING_V1":  1,
+		"VESTING_V2":  2,
+	}
+)
+
+func (x VestingContract) Enum() *VestingContract {
+	p := new(VestingContract)
+	*p = x
+	return p
+}
+
+func (x VestingContract) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VestingContract) Descriptor() protoreflect.EnumDescriptor {
+	return file_vesting_pb_vesting_proto_enumTypes[0].Descriptor()
+}
+
+func (VestingContract) Type() protoreflect.EnumType {
+	return &file_vesting_pb_vesting_proto_enumTypes[0]
+}
+
+func (x VestingContract) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VestingContract.Descriptor instead.
+func (VestingContract) EnumDescriptor() ([]byte, []int) {
+	return file_vesting_pb_vesting_proto_rawDescGZIP(), []int{0}
+}
+
+type GetVestingContractRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Contract VestingContract `protobuf:"varint,1,opt,name=contract,proto3,enum=vesting_pb.VestingContract" json:"contract,omitempty"`
+	// Latest block when unset
+	At *block_pb.AtBlock `protobuf:"bytes,2,opt,name=at,proto3" json:"at,omitempty"`
+}
+
+func (x *GetVestingContractRequest) Reset() {
+	*x = GetVestingContractRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vesting_pb_vesting_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetVestingContractRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVestingContractRequest) ProtoMessage() {}
+
+func (x *GetVestingContractRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vesting_pb_vesting_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVestingContractRequest.ProtoReflect.Descriptor instead.
+func (*GetVestingContractRequest) Descriptor() ([]byte, []int) {
+	return file_vesting_pb_vesting_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetVestingContractRequest) GetContract() VestingContract {
+	if x != nil {
+		return x.Contract
+	}
+	return VestingContract_UNSPECIFIED
+}
+
+func (x *GetVestingContractRequest) GetAt() *block_pb.AtBlock {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+type VestingContractInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Contract VestingContract `protobuf:"varint,1,opt,name=contract,proto3,enum=vesting_pb.VestingContract" json:"contract,omitempty"`
+	Address  string          `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Token    string          `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	Started  bool            `protobuf:"varint,4,opt,name=started,proto3" json:"started,omitempty"`
+	// Unix seconds, 0 until started
+	StartTime uint64 `protobuf:"varint,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// Seconds between two unlocks, 0 when unknown
+	SplitDuration uint64 `protobuf:"varint,6,opt,name=split_duration,json=splitDuration,proto3" json:"split_duration,omitempty"`
+	SplitCount    uint64 `protobuf:"varint,7,opt,name=split_count,json=splitCount,proto3" json:"split_count,omitempty"`
+	// Amounts are base 10 strings in the token's smallest unit, empty when the contract does not expose them
+	InitializedAmount     string `protobuf:"bytes,8,opt,name=initialized_amount,json=initializedAmount,proto3" json:"initialized_amount,omitempty"`
+	InitializedAmountLeft string `protobuf:"bytes,9,opt,name=initialized_amount_left,json=initializedAmountLeft,proto3" json:"initialized_amount_left,omitempty"`
+	Balance               string `protobuf:"bytes,10,opt,name=balance,proto3" json:"balance,omitempty"`
+}
+
+func (x *VestingContractInfo) Reset() {
+	*x = VestingContractInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vesting_pb_vesting_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VestingContractInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VestingContractInfo) ProtoMessage() {}
+
+func (x *VestingContractInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_vesting_pb_vesting_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VestingContractInfo.ProtoReflect.Descriptor instead.
+func (*VestingContractInfo) Descriptor() ([]byte, []int) {
+	return file_vesting_pb_vesting_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *VestingContractInfo) GetContract() VestingContract {
+	if x != nil {
+		return x.Contract
+	}
+	return VestingContract_UNSPECIFIED
+}
+
+func (x *VestingContractInfo) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *VestingContractInfo) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *VestingContractInfo) GetStarted() bool {
+	if x != nil {
+		return x.Started
+	}
+	return false
+}
+
+func (x *VestingContractInfo) GetStartTime() uint64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *VestingContractInfo) GetSplitDuration() uint64 {
+	if x != nil {
+		return x.SplitDuration
+	}
+	return 0
+}
+
+func (x *VestingContractInfo) GetSplitCount() uint64 {
+	if x != nil {
+		return x.SplitCount
+	}
+	return 0
+}
+
+func (x *VestingContractInfo) GetInitializedAmount() string {
+	if x != nil {
+		return x.InitializedAmount
+	}
+	return ""
+}
+
+func (x *VestingContractInfo) GetInitializedAmountLeft() string {
+	if x != nil {
+		return x.InitializedAmountLeft
+	}
+	return ""
+}
+
+func (x *VestingContractInfo) GetBalance() string {
+	if x != nil {
+		return x.Balance
+	}
+	return ""
+}
+
+type GetBeneficiarySchedulesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Beneficiary string `protobuf:"bytes,1,opt,name=beneficiary,proto3" json:"beneficiary,omitempty"`
+	// Latest block when unset
+	At *block_pb.AtBlock `protobuf:"bytes,2,opt,name=at,proto3" json:"at,omitempty"`
+}
+
+func (x *GetBeneficiarySchedulesRequest) Reset() {
+	*x = GetBeneficiarySchedulesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vesting_pb_vesting_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBeneficiarySchedulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBeneficiarySchedulesRequest) ProtoMessage() {}
+
+func (x *GetBeneficiarySchedulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vesting_pb_vesting_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBeneficiarySchedulesRequest.ProtoReflect.Descriptor instead.
+func (*GetBeneficiarySchedulesRequest) Descriptor() ([]byte, []int) {
+	return file_vesting_pb_vesting_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetBeneficiarySchedulesRequest) GetBeneficiary() string {
+	if x != nil {
+		return x.Beneficiary
+	}
+	return ""
+}
+
+func (x *GetBeneficiarySchedulesRequest) GetAt() *block_pb.AtBlock {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+type VestingSchedule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Contract    VestingContract `protobuf:"varint,1,opt,name=contract,proto3,enum=vesting_pb.VestingContract" json:"contract,omitempty"`
+	Beneficiary string          `protobuf:"bytes,2,opt,name=beneficiary,proto3" json:"beneficiary,omitempty"`
+	TotalAmount string          `protobuf:"bytes,3,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+	// Released at the first unlock, always 0 on V1
+	TgeAmount string `protobuf:"bytes,4,opt,name=tge_amount,json=tgeAmount,proto3" json:"tge_amount,omitempty"`
+	// Splits before the first unlock, always 0 on V1
+	Cliff     uint64 `protobuf:"varint,5,opt,name=cliff,proto3" json:"cliff,omitempty"`
+	Withdrawn string `protobuf:"bytes,6,opt,name=withdrawn,proto3" json:"withdrawn,omitempty"`
+	// 0 until the schedule is started
+	Withdrawable string `protobuf:"bytes,7,opt,name=withdrawable,proto3" json:"withdrawable,omitempty"`
+	// Unix seconds of the next unlock, 0 when fully unlocked or unknown
+	NextUnlockTime uint64 `protobuf:"varint,8,opt,name=next_unlock_time,json=nextUnlockTime,proto3" json:"next_unlock_time,omitempty"`
+}
+
+func (x *VestingSchedule) Reset() {
+	*x = VestingSchedule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vesting_pb_vesting_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VestingSchedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VestingSchedule) ProtoMessage() {}
+
+func (x *VestingSchedule) ProtoReflect() protoreflect.Message {
+	mi := &file_vesting_pb_vesting_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VestingSchedule.ProtoReflect.Descriptor instead.
+func (*VestingSchedule) Descriptor() ([]byte, []int) {
+	return file_vesting_pb_vesting_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *VestingSchedule) GetContract() VestingContract {
+	if x != nil {
+		return x.Contract
+	}
+	return VestingContract_UNSPECIFIED
+}
+
+func (x *VestingSchedule) GetBeneficiary() string {
+	if x != nil {
+		return x.Beneficiary
+	}
+	return ""
+}
+
+func (x *VestingSchedule) GetTotalAmount() string {
+	if x != nil {
+		return x.TotalAmount
+	}
+	return ""
+}
+
+func (x *VestingSchedule) GetTgeAmount() string {
+	if x != nil {
+		return x.TgeAmount
+	}
+	return ""
+}
+
+func (x *VestingSchedule) GetCliff() uint64 {
+	if x != nil {
+		return x.Cliff
+	}
+	return 0
+}
+
+func (x *VestingSchedule) GetWithdrawn() string {
+	if x != nil {
+		return x.Withdrawn
+	}
+	return ""
+}
+
+func (x *VestingSchedule) GetWithdrawable() string {
+	if x != nil {
+		return x.Withdrawable
+	}
+	return ""
+}
+
+func (x *VestingSchedule) GetNextUnlockTime() uint64 {
+	if x != nil {
+		return x.NextUnlockTime
+	}
+	return 0
+}
+
+type GetBeneficiarySchedulesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Empty when the address is not a beneficiary
+	Schedules []*VestingSchedule `protobuf:"bytes,1,rep,name=schedules,proto3" json:"schedules,omitempty"`
+}
+
+func (x *GetBeneficiarySchedulesResponse) Reset() {
+	*x = GetBeneficiarySchedulesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vesting_pb_vesting_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBeneficiarySchedulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBeneficiarySchedulesResponse) ProtoMessage() {}
+
+func (x *GetBeneficiarySchedulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vesting_pb_vesting_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBeneficiarySchedulesResponse.ProtoReflect.Descriptor instead.
+func (*GetBeneficiarySchedulesResponse) Descriptor() ([]byte, []int) {
+	return file_vesting_pb_vesting_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetBeneficiarySchedulesResponse) GetSchedules() []*VestingSchedule {
+	if x != nil {
+		return x.Schedules
+	}
+	return nil
+}
+
+var File_vesting_pb_vesting_proto protoreflect.FileDescriptor
+
+var file_vesting_pb_vesting_proto_rawDesc = []byte{
+	0x0a, 0x18, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x62, 0x2f, 0x76, 0x65, 0x73,
+	0x74, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0a, 0x76, 0x65, 0x73, 0x74,
+	0x69, 0x6e, 0x67, 0x5f, 0x70, 0x62, 0x1a, 0x1a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2f, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x5f, 0x70, 0x62, 0x2f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0x77, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x56, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67,
+	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x37, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1b, 0x2e, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x62, 0x2e, 0x56,
+	0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x08,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x21, 0x0a, 0x02, 0x61, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x70, 0x62, 0x2e,
+	0x41, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x02, 0x61, 0x74, 0x22, 0x80, 0x03, 0x0a, 0x13,
+	0x56, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x37, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5f,
+	0x70, 0x62, 0x2e, 0x56, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x18, 0x0a, 0x07,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x5f, 0x64,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x73,
+	0x70, 0x6c, 0x69, 0x74, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b,
+	0x73, 0x70, 0x6c, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0a, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2d, 0x0a,
+	0x12, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x69, 0x6e, 0x69, 0x74, 0x69,
+	0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x36, 0x0a, 0x17,
+	0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x5f, 0x6c, 0x65, 0x66, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x69,
+	0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x4c, 0x65, 0x66, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x65,
+	0x0a, 0x1e, 0x47, 0x65, 0x74, 0x42, 0x65, 0x6e, 0x65, 0x66, 0x69, 0x63, 0x69, 0x61, 0x72, 0x79,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x20, 0x0a, 0x0b, 0x62, 0x65, 0x6e, 0x65, 0x66, 0x69, 0x63, 0x69, 0x61, 0x72, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x65, 0x6e, 0x65, 0x66, 0x69, 0x63, 0x69, 0x61,
+	0x72, 0x79, 0x12, 0x21, 0x0a, 0x02, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x70, 0x62, 0x2e, 0x41, 0x74, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x52, 0x02, 0x61, 0x74, 0x22, 0xb0, 0x02, 0x0a, 0x0f, 0x56, 0x65, 0x73, 0x74, 0x69, 0x6e,
+	0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x37, 0x0a, 0x08, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x61, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x76, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x62, 0x2e, 0x56, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67,
+	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x62, 0x65, 0x6e, 0x65, 0x66, 0x69, 0x63, 0x69, 0x61, 0x72,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x65, 0x6e, 0x65, 0x66, 0x69, 0x63,
+	0x69, 0x61, 0x72, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x67, 0x65, 0x5f, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x67, 0x65,
+	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x69, 0x66, 0x66, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63, 0x6c, 0x69, 0x66, 0x66, 0x12, 0x1c, 0x0a, 0x09,
+	0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x77, 0x69,
+	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x28,
+	0x0a, 0x10, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x6e, 0x65, 0x78, 0x74, 0x55, 0x6e,
+	0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x5c, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x42,
+	0x65, 0x6e, 0x65, 0x66, 0x69, 0x63, 0x69, 0x61, 0x72, 0x79, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x09, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x62, 0x2e, 0x56, 0x65, 0x73, 0x74,
+	0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x09, 0x73, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x2a, 0x42, 0x0a, 0x0f, 0x56, 0x65, 0x73, 0x74, 0x69, 0x6e,
+	0x67, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x56, 0x45,
+	0x53, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x56, 0x31, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x56, 0x45,
+	0x53, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x56, 0x32, 0x10, 0x02, 0x32, 0xe2, 0x01, 0x0a, 0x0e, 0x56,
+	0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5c, 0x0a,
+	0x12, 0x47, 0x65, 0x74, 0x56, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x12, 0x25, 0x2e, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x62,
+	0x2e, 0x47, 0x65, 0x74, 0x56, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x76, 0x65, 0x73,
+	0x74, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x62, 0x2e, 0x56, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x72, 0x0a, 0x17, 0x47,
+	0x65, 0x74, 0x42, 0x65, 0x6e, 0x65, 0x66, 0x69, 0x63, 0x69, 0x61, 0x72, 0x79, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x2a, 0x2e, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67,
+	0x5f, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x65, 0x6e, 0x65, 0x66, 0x69, 0x63, 0x69, 0x61,
+	0x72, 0x79, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x62, 0x2e,
+	0x47, 0x65, 0x74, 0x42, 0x65, 0x6e, 0x65, 0x66, 0x69, 0x63, 0x69, 0x61, 0x72, 0x79, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42,
+	0x0d, 0x5a, 0x0b, 0x2f, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x62, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_vesting_pb_vesting_proto_rawDescOnce sync.Once
+	file_vesting_pb_vesting_proto_rawDescData = file_vesting_pb_vesting_proto_rawDesc
+)
+
+func file_vesting_pb_vesting_proto_rawDescGZIP() []byte {
+	file_vesting_pb_vesting_proto_rawDescOnce.Do(func() {
+		file_vesting_pb_vesting_proto_rawDescData = protoimpl.X.CompressGZIP(file_vesting_pb_vesting_proto_rawDescData)
+	})
+	return file_vesting_pb_vesting_proto_rawDescData
+}
+
+var file_vesting_pb_vesting_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_vesting_pb_vesting_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_vesting_pb_vesting_proto_goTypes = []interface{}{
+	(VestingContract)(0),                    // 0: vesting_pb.VestingContract
+	(*GetVestingContractRequest)(nil),       // 1: vesting_pb.GetVestingContractRequest
+	(*VestingContractInfo)(nil),             // 2: vesting_pb.VestingContractInfo
+	(*GetBeneficiarySchedulesRequest)(nil),  // 3: vesting_pb.GetBeneficiarySchedulesRequest
+	(*VestingSchedule)(nil),                 // 4: vesting_pb.VestingSchedule
+	(*GetBeneficiarySchedulesResponse)(nil), // 5: vesting_pb.GetBeneficiarySchedulesResponse
+	(*block_pb.AtBlock)(nil),                // 6: block_pb.AtBlock
+}
+var file_vesting_pb_vesting_proto_depIdxs = []int32{
+	0, // 0: vesting_pb.GetVestingContractRequest.contract:type_name -> vesting_pb.VestingContract
+	6, // 1: vesting_pb.GetVestingContractRequest.at:type_name -> block_pb.AtBlock
+	0, // 2: vesting_pb.VestingContractInfo.contract:type_name -> vesting_pb.VestingContract
+	6, // 3: vesting_pb.GetBeneficiarySchedulesRequest.at:type_name -> block_pb.AtBlock
+	0, // 4: vesting_pb.VestingSchedule.contract:type_name -> vesting_pb.VestingContract
+	4, // 5: vesting_pb.GetBeneficiarySchedulesResponse.schedules:type_name -> vesting_pb.VestingSchedule
+	1, // 6: vesting_pb.VestingService.GetVestingContract:input_type -> vesting_pb.GetVestingContractRequest
+	3, // 7: vesting_pb.VestingService.GetBeneficiarySchedules:input_type -> vesting_pb.GetBeneficiarySchedulesRequest
+	2, // 8: vesting_pb.VestingService.GetVestingContract:output_type -> vesting_pb.VestingContractInfo
+	5, // 9: vesting_pb.VestingService.GetBeneficiarySchedules:output_type -> vesting_pb.GetBeneficiarySchedulesResponse
+	8, // [8:10] is the sub-list for method output_type
+	6, // [6:8] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_vesting_pb_vesting_proto_init() }
+func file_vesting_pb_vesting_proto_init() {
+	if File_vesting_pb_vesting_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_vesting_pb_vesting_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVestingContractRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vesting_pb_vesting_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VestingContractInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vesting_pb_vesting_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBeneficiarySchedulesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vesting_pb_vesting_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VestingSchedule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vesting_pb_vesting_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBeneficiarySchedulesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_vesting_pb_vesting_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_vesting_pb_vesting_proto_goTypes,
+		DependencyIndexes: file_vesting_pb_vesting_proto_depIdxs,
+		EnumInfos:         file_vesting_pb_vesting_proto_enumTypes,
+		MessageInfos:      file_vesting_pb_vesting_proto_msgTypes,
+	}.Build()
+	File_vesting_pb_vesting_proto = out.File
+	file_vesting_pb_vesting_proto_rawDesc = nil
+	file_vesting_pb_vesting_proto_goTypes = nil
+	file_vesting_pb_vesting_proto_depIdxs = nil
+}
@@ -0,0 +1,141 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package vesting_pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// VestingServiceClient is the client API for VestingService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type VestingServiceClient interface {
+	// Returns the schedule settings and balances of a vesting contract
+	GetVestingContract(ctx context.Context, in *GetVestingContractRequest, opts ...grpc.CallOption) (*VestingContractInfo, error)
+	// Returns the schedules of a beneficiary in every deployed vesting contract
+	GetBeneficiarySchedules(ctx context.Context, in *GetBeneficiarySchedulesRequest, opts ...grpc.CallOption) (*GetBeneficiarySchedulesResponse, error)
+}
+
+type vestingServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewVestingServiceClient(cc grpc.ClientConnInterface) VestingServiceClient {
+	return &vestingServiceClient{cc}
+}
+
+func (c *vestingServiceClient) GetVestingContract(ctx context.Context, in *GetVestingContractRequest, opts ...grpc.CallOption) (*VestingContractInfo, error) {
+	out := new(VestingContractInfo)
+	err := c.cc.Invoke(ctx, "/vesting_pb.VestingService/GetVestingContract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vestingServiceClient) GetBeneficiarySchedules(ctx context.Context, in *GetBeneficiarySchedulesRequest, opts ...grpc.CallOption) (*GetBeneficiarySchedulesResponse, error) {
+	out := new(GetBeneficiarySchedulesResponse)
+	err := c.cc.Invoke(ctx, "/vesting_pb.VestingService/GetBeneficiarySchedules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// VestingServiceServer is the server API for VestingService service.
+// All implementations must embed UnimplementedVestingServiceServer
+// for forward compatibility
+type VestingServiceServer interface {
+	// Returns the schedule settings and balances of a vesting contract
+	GetVestingContract(context.Context, *GetVestingContractRequest) (*VestingContractInfo, error)
+	// Returns the schedules of a beneficiary in every deployed vesting contract
+	GetBeneficiarySchedules(context.Context, *GetBeneficiarySchedulesRequest) (*GetBeneficiarySchedulesResponse, error)
+	mustEmbedUnimplementedVestingServiceServer()
+}
+
+// UnimplementedVestingServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedVestingServiceServer struct {
+}
+
+func (UnimplementedVestingServiceServer) GetVestingContract(context.Context, *GetVestingContractRequest) (*VestingContractInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVestingContract not implemented")
+}
+func (UnimplementedVestingServiceServer) GetBeneficiarySchedules(context.Context, *GetBeneficiarySchedulesRequest) (*GetBeneficiarySchedulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBeneficiarySchedules not implemented")
+}
+func (UnimplementedVestingServiceServer) mustEmbedUnimplementedVestingServiceServer() {}
+
+// UnsafeVestingServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to VestingServiceServer will
+// result in compilation errors.
+type UnsafeVestingServiceServer interface {
+	mustEmbedUnimplementedVestingServiceServer()
+}
+
+func RegisterVestingServiceServer(s grpc.ServiceRegistrar, srv VestingServiceServer) {
+	s.RegisterService(&VestingService_ServiceDesc, srv)
+}
+
+func _VestingService_GetVestingContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVestingContractRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VestingServiceServer).GetVestingContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vesting_pb.VestingService/GetVestingContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VestingServiceServer).GetVestingContract(ctx, req.(*GetVestingContractRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VestingService_GetBeneficiarySchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBeneficiarySchedulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VestingServiceServer).GetBeneficiarySchedules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vesting_pb.VestingService/GetBeneficiarySchedules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VestingServiceServer).GetBeneficiarySchedules(ctx, req.(*GetBeneficiarySchedulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// VestingService_ServiceDesc is the grpc.ServiceDesc for VestingService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var VestingService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "vesting_pb.VestingService",
+	HandlerType: (*VestingServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetVestingContract",
+			Handler:    _VestingService_GetVestingContract_Handler,
+		},
+		{
+			MethodName: "GetBeneficiarySchedules",
+			Handler:    _VestingService_GetBeneficiarySchedules_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "vesting_pb/vesting.proto",
+}
@@ -0,0 +1,286 @@
+package vesting
+
+import (
+	"context"
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/mineloop99/new-token/back_end/features/vesting/vesting_pb"
+	"github.com/mineloop99/new-token/back_end/utils"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Solidity panic code of an arithmetic underflow
+const PANIC_UNDERFLOW = 0x11
+
+var contractNames = map[vesting_pb.VestingContract]string{
+	vesting_pb.VestingContract_VESTING_V1: utils.ANIWAR_VESTING,
+	vesting_pb.VestingContract_VESTING_V2: utils.ANIWAR_VESTING_V2,
+}
+
+type Server struct {
+	vesting_pb.UnimplementedVestingServiceServer
+}
+
+func RewardRegister(s grpc.ServiceRegistrar) {
+	vesting_pb.RegisterVestingServiceServer(s, &Server{})
+}
+
+// contractInfo is the part of both vesting contracts the schedules are computed from
+type contractInfo struct {
+	started       bool
+	startTime     uint64
+	splitDuration uint64
+	splitCount    uint64
+}
+
+func (*Server) GetVestingContract(ctx context.Context, in *vesting_pb.GetVestingContractRequest) (*vesting_pb.VestingContractInfo, error) {
+	config, err := utils.GetConfig()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "GetVestingContract: Cannot get config: %v", err)
+	}
+	contractName, ok := contractNames[in.GetContract()]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "GetVestingContract: Unknown vesting contract %v", in.GetContract())
+	}
+	config, err = utils.AtBlock(ctx, config, in.GetAt())
+	if err != nil {
+		return nil, utils.StatusError(err)
+	}
+	contract, err := config.GetContract(contractName)
+	if err != nil {
+		return nil, utils.StatusError(err)
+	}
+	result, err := utils.CallViewMethods(config, contractName, "getToken", big.NewInt(0))
+	if err != nil {
+		return nil, utils.StatusError(err)
+	}
+	token := result[0].(common.Address)
+	result, err = utils.CallViewMethods(config, contractName, "getBalance", big.NewInt(0))
+	if err != nil {
+		return nil, utils.StatusError(err)
+	}
+	balance := result[0].(*big.Int)
+
+	response := &vesting_pb.VestingContractInfo{
+		Contract: in.GetContract(),
+		Address:  contract.Address.Hex(),
+		Token:    token.Hex(),
+		Balance:  balance.String(),
+	}
+	var info contractInfo
+	if in.GetContract() == vesting_pb.VestingContract_VESTING_V2 {
+		result, err = utils.CallViewMethods(config, contractName, "getContractInfo", big.NewInt(0))
+		if err != nil {
+			return nil, utils.StatusError(err)
+		}
+		info = contractInfoV2(result)
+		response.InitializedAmount = result[4].(*big.Int).String()
+		response.InitializedAmountLeft = result[5].(*big.Int).String()
+	} else {
+		info, err = getContractInfoV1(config)
+		if err != nil {
+			return nil, utils.StatusError(err)
+		}
+	}
+	response.Started = info.started
+	response.StartTime = info.startTime
+	response.SplitDuration = info.splitDuration
+	response.SplitCount = info.splitCount
+	return response, nil
+}
+
+func (*Server) GetBeneficiarySchedules(ctx context.Context, in *vesting_pb.GetBeneficiarySchedulesRequest) (*vesting_pb.GetBeneficiarySchedulesResponse, error) {
+	config, err := utils.GetConfig()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "GetBeneficiarySchedules: Cannot get config: %v", err)
+	}
+	if !common.IsHexAddress(in.GetBeneficiary()) {
+		return nil, status.Errorf(codes.InvalidArgument, "GetBeneficiarySchedules: Invalid address %q", in.GetBeneficiary())
+	}
+	beneficiary := common.HexToAddress(in.GetBeneficiary())
+	config, err = utils.AtBlock(ctx, config, in.GetAt())
+	if err != nil {
+		return nil, utils.StatusError(err)
+	}
+	// The contracts read block.timestamp, use the read block rather than the server clock
+	header, err := config.Header(ctx)
+	if err != nil {
+		return nil, utils.StatusError(err)
+	}
+
+	var schedules []*vesting_pb.VestingSchedule
+	for _, getSchedule := range []func(utils.Config, common.Address, uint64) (*vesting_pb.VestingSchedule, error){getScheduleV1, getScheduleV2} {
+		schedule, err := getSchedule(config, beneficiary, header.Time)
+		if errors.Is(err, utils.ErrNotDeployed) {
+			continue
+		}
+		if err != nil {
+			return nil, utils.StatusError(err)
+		}
+		if schedule != nil {
+			schedules = append(schedules, schedule)
+		}
+	}
+	return &vesting_pb.GetBeneficiarySchedulesResponse{Schedules: schedules}, nil
+}
+
+// getScheduleV1 finds the schedule of beneficiary in the public vestingSchedules array, the beneficiary mapping is private
+func getScheduleV1(config utils.Config, beneficiary common.Address, now uint64) (*vesting_pb.VestingSchedule, error) {
+	if _, err := config.GetContract(utils.ANIWAR_VESTING); err != nil {
+		return nil, err
+	}
+	result, err := utils.CallViewMethods(config, utils.ANIWAR_VESTING, "vestingIdCounter", big.NewInt(0))
+	if err != nil {
+		return nil, err
+	}
+	count := result[0].(*big.Int).Uint64()
+	var calls []*utils.ViewCall
+	for i := uint64(0); i < count; i++ {
+		calls = append(calls, &utils.ViewCall{ContractName: utils.ANIWAR_VESTING, MethodName: "vestingSchedules", Args: []interface{}{new(big.Int).SetUint64(i)}})
+	}
+	err = utils.BatchCallViewMethods(config, calls)
+	if err != nil {
+		return nil, err
+	}
+	var schedule *vesting_pb.VestingSchedule
+	for _, call := range calls {
+		if call.Err != nil {
+			return nil, call.Err
+		}
+		// (id, beneficiary, totalAmountReleased, totalAmountHasBeenWithdrawn)
+		if call.Result[1].(common.Address) == beneficiary {
+			schedule = &vesting_pb.VestingSchedule{
+				Contract:     vesting_pb.VestingContract_VESTING_V1,
+				Beneficiary:  beneficiary.Hex(),
+				TotalAmount:  call.Result[2].(*big.Int).String(),
+				TgeAmount:    "0",
+				Withdrawn:    call.Result[3].(*big.Int).String(),
+				Withdrawable: "0",
+			}
+			break
+		}
+	}
+	if schedule == nil {
+		return nil, nil
+	}
+
+	info, err := getContractInfoV1(config)
+	if err != nil {
+		return nil, err
+	}
+	if !info.started {
+		return schedule, nil
+	}
+	result, err = utils.CallViewMethods(config, utils.ANIWAR_VESTING, "calculateWithdrawable", big.NewInt(0), beneficiary)
+	if err != nil {
+		return nil, err
+	}
+	schedule.Withdrawable = result[0].(*big.Int).String()
+	schedule.NextUnlockTime = nextUnlockTime(info, 1, now)
+	return schedule, nil
+}
+
+func getScheduleV2(config utils.Config, beneficiary common.Address, now uint64) (*vesting_pb.VestingSchedule, error) {
+	result, err := utils.CallViewMethods(config, utils.ANIWAR_VESTING_V2, "beneficiaries", big.NewInt(0), beneficiary)
+	if err != nil {
+		return nil, err
+	}
+	// (totalAmount, TGEAmount, cliff, totalAmountHasBeenWithdrawn, isInitialized)
+	if !result[4].(bool) {
+		return nil, nil
+	}
+	cliff := result[2].(*big.Int).Uint64()
+	schedule := &vesting_pb.VestingSchedule{
+		Contract:     vesting_pb.VestingContract_VESTING_V2,
+		Beneficiary:  beneficiary.Hex(),
+		TotalAmount:  result[0].(*big.Int).String(),
+		TgeAmount:    result[1].(*big.Int).String(),
+		Cliff:        cliff,
+		Withdrawn:    result[3].(*big.Int).String(),
+		Withdrawable: "0",
+	}
+
+	result, err = utils.CallViewMethods(config, utils.ANIWAR_VESTING_V2, "getContractInfo", big.NewInt(0))
+	if err != nil {
+		return nil, err
+	}
+	info := contractInfoV2(result)
+	if !info.started {
+		return schedule, nil
+	}
+	result, err = utils.CallViewMethods(config, utils.ANIWAR_VESTING_V2, "calculateWithdrawableAmount", big.NewInt(0), beneficiary)
+	var revertErr *utils.RevertError
+	switch {
+	case err == nil:
+		schedule.Withdrawable = result[0].(*big.Int).String()
+	case errors.As(err, &revertErr) && revertErr.PanicCode != nil && revertErr.PanicCode.Uint64() == PANIC_UNDERFLOW:
+		// calculateWithdrawableAmount underflows during the last split of the cliff, nothing is unlocked yet
+	default:
+		return nil, err
+	}
+	// Amounts unlock from split cliff + 1, see calculateWithdrawableAmount
+	schedule.NextUnlockTime = nextUnlockTime(info, cliff+1, now)
+	return schedule, nil
+}
+
+// getContractInfoV1 reads the start of AniwarVesting. Its split duration and count are private,
+// they are read from chains.<chainId>.vesting in config.yaml and left at 0 when missing
+func getContractInfoV1(config utils.Config) (contractInfo, error) {
+	result, err := utils.CallViewMethods(config, utils.ANIWAR_VESTING, "isStarted", big.NewInt(0))
+	if err != nil {
+		return contractInfo{}, err
+	}
+	started := result[0].(bool)
+	result, err = utils.CallViewMethods(config, utils.ANIWAR_VESTING, "startTime", big.NewInt(0))
+	if err != nil {
+		return contractInfo{}, err
+	}
+	key := "chains." + config.ChainId.String() + ".vesting."
+	return contractInfo{
+		started:       started,
+		startTime:     result[0].(*big.Int).Uint64(),
+		splitDuration: viper.GetUint64(key + "splitDuration"),
+		splitCount:    viper.GetUint64(key + "splitCount"),
+	}, nil
+}
+
+// contractInfoV2 reads the result of getContractInfo
+func contractInfoV2(result []interface{}) contractInfo {
+	return contractInfo{
+		started:       result[0].(bool),
+		startTime:     result[1].(*big.Int).Uint64(),
+		splitDuration: result[2].(*big.Int).Uint64(),
+		splitCount:    result[3].(*big.Int).Uint64(),
+	}
+}
+
+// nextUnlockTime returns when the withdrawable amount next grows, 0 when fully unlocked or unknown.
+// Both contracts count split 0 as split 1, so split 1 unlocks at the start and split k >= 2 at
+// start + k * splitDuration. firstSplit is the first split with an amount
+func nextUnlockTime(info contractInfo, firstSplit uint64, now uint64) uint64 {
+	if info.splitDuration == 0 || firstSplit > info.splitCount {
+		return 0
+	}
+	unlockTime := func(split uint64) uint64 {
+		if split <= 1 {
+			return info.startTime
+		}
+		return info.startTime + split*info.splitDuration
+	}
+	if now < unlockTime(firstSplit) {
+		return unlockTime(firstSplit)
+	}
+	split := (now-info.startTime)/info.splitDuration + 1
+	if split < 2 {
+		split = 2
+	}
+	if split > info.splitCount {
+		return 0
+	}
+	return unlockTime(split)
+}
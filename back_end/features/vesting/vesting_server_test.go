@@ -0,0 +1,74 @@
+package vesting
+
+import "testing"
+
+// currentSplit is the split count of calculateWithdrawable in both vesting contracts:
+// (now - start) / splitDuration clamped to splitCount, and split 0 counted as split 1
+func currentSplit(info contractInfo, now uint64) uint64 {
+	if now < info.startTime {
+		return 0
+	}
+	split := (now - info.startTime) / info.splitDuration
+	if split > info.splitCount {
+		split = info.splitCount
+	}
+	if split == 0 {
+		split = 1
+	}
+	return split
+}
+
+func TestNextUnlockTime(t *testing.T) {
+	info := contractInfo{started: true, startTime: 1000, splitDuration: 100, splitCount: 5}
+	tests := []struct {
+		name       string
+		info       contractInfo
+		firstSplit uint64
+		now        uint64
+		want       uint64
+	}{
+		{"no split duration", contractInfo{startTime: 1000, splitCount: 5}, 1, 1000, 0},
+		{"first split after the last", info, 6, 1000, 0},
+		{"before the start", info, 1, 999, 1000},
+		// split 0 is counted as split 1, split 2 is the next to unlock
+		{"at the start", info, 1, 1000, 1200},
+		{"during split 1", info, 1, 1150, 1200},
+		{"at split 2", info, 1, 1200, 1300},
+		{"before the last split", info, 1, 1499, 1500},
+		{"fully unlocked", info, 1, 1500, 0},
+		{"later first split", info, 3, 1000, 1300},
+		{"at the first split", info, 3, 1300, 1400},
+	}
+	for _, test := range tests {
+		if got := nextUnlockTime(test.info, test.firstSplit, test.now); got != test.want {
+			t.Errorf("%s: got %d, want %d", test.name, got, test.want)
+		}
+	}
+}
+
+// The next unlock is the first second the contracts count more splits with an amount
+func TestNextUnlockTimeMatchesContracts(t *testing.T) {
+	info := contractInfo{started: true, startTime: 1000, splitDuration: 7, splitCount: 6}
+	end := info.startTime + (info.splitCount+2)*info.splitDuration
+	unlocked := func(firstSplit uint64, now uint64) uint64 {
+		split := currentSplit(info, now)
+		if split < firstSplit {
+			return 0
+		}
+		return split - firstSplit + 1
+	}
+	for firstSplit := uint64(1); firstSplit <= info.splitCount+1; firstSplit++ {
+		for now := info.startTime - 10; now < end; now++ {
+			want := uint64(0)
+			for later := now + 1; later <= end; later++ {
+				if unlocked(firstSplit, later) > unlocked(firstSplit, now) {
+					want = later
+					break
+				}
+			}
+			if got := nextUnlockTime(info, firstSplit, now); got != want {
+				t.Fatalf("first split %d at %d: got %d, want %d", firstSplit, now, got, want)
+			}
+		}
+	}
+}
@@ -0,0 +1,418 @@
+package indexer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math/big"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/mineloop99/new-token/back_end/utils"
+	"github.com/spf13/viper"
+)
+
+const DEFAULT_STORE_PATH = "./data/indexer"
+
+// Blocks below the head whose hashes are kept to find the fork point of a reorg, at least the confirmation depth
+const REORG_WINDOW = 128
+
+// Watch lists the events of a registry contract the indexer stores
+type Watch struct {
+	Contract string
+	Events   []string
+}
+
+// DEFAULT_WATCHES are the events the backend acts on. AniwarFarm emits no event
+var DEFAULT_WATCHES = []Watch{
+	{Contract: utils.ANIWAR_TOKEN, Events: []string{"Transfer"}},
+	{Contract: utils.ANIWAR_NFT, Events: []string{"requestedAniwarItem"}},
+	{Contract: utils.ANIWAR_POOL, Events: []string{"EnterStaking", "LeaveStaking", "ClaimReward", "EmergencyWithdraw"}},
+	{Contract: utils.SPEND_ANI, Events: []string{"PurchaseItem"}},
+	{Contract: utils.ANIWAR_VESTING, Events: []string{"Released"}},
+	{Contract: utils.ANIWAR_VESTING_V2, Events: []string{"Released"}},
+}
+
+// Indexer polls the logs of the watched contracts and stores them decoded
+type Indexer struct {
+	config       utils.Config
+	store        *Store
+	pollInterval time.Duration
+	contracts    map[common.Address]utils.Contract
+	addresses    []common.Address
+	eventIds     []common.Hash
+	startBlock   uint64
+	// Indexed event names by contract name
+	watched map[string]map[string]bool
+	// Blocks, the mined one included, after which an event is final
+	confirmations uint64
+
+	headMu sync.Mutex
+	head   uint64
+
+	subsMu sync.Mutex
+	subs   map[*Subscription]struct{}
+}
+
+var indexer *Indexer
+
+// InitIndexer starts the indexer when indexer.enabled is set in config.yaml
+func InitIndexer(config utils.Config) error {
+	if !viper.GetBool("indexer.enabled") {
+		return nil
+	}
+	viper.SetDefault("indexer.path", DEFAULT_STORE_PATH)
+	store, err := OpenStore(viper.GetString("indexer.path"))
+	if err != nil {
+		return err
+	}
+	indexer, err = New(config, store, DEFAULT_WATCHES)
+	if err != nil {
+		return err
+	}
+	go indexer.Run(context.Background())
+	return nil
+}
+
+// GetIndexer returns the running indexer, nil when it is disabled
+func GetIndexer() *Indexer {
+	return indexer
+}
+
+// New prepares an indexer for the watched events. Contracts not deployed on the chain and events
+// missing from the loaded ABIs are skipped with a warning
+func New(config utils.Config, store *Store, watches []Watch) (*Indexer, error) {
+	idx := &Indexer{
+		config:        config,
+		store:         store,
+		pollInterval:  config.Tracker.PollInterval(),
+		contracts:     make(map[common.Address]utils.Contract),
+		confirmations: config.Tracker.Confirmations(),
+		subs:          make(map[*Subscription]struct{}),
+		watched:       make(map[string]map[string]bool),
+	}
+	if idx.pollInterval <= 0 {
+		idx.pollInterval = time.Second * 3
+	}
+	seen := make(map[common.Hash]bool)
+	first := true
+	for _, watch := range watches {
+		contract, err := config.GetContract(watch.Contract)
+		if errors.Is(err, utils.ErrNotDeployed) {
+			log.Printf("Indexer: %s is not deployed, its events are not indexed", watch.Contract)
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		found := false
+		for _, name := range watch.Events {
+			event, ok := contract.ABI.Events[name]
+			if !ok {
+				log.Printf("Indexer: %s.%s is not in the ABI, it is not indexed", watch.Contract, name)
+				continue
+			}
+			found = true
+			if idx.watched[watch.Contract] == nil {
+				idx.watched[watch.Contract] = make(map[string]bool)
+			}
+			idx.watched[watch.Contract][name] = true
+			if !seen[event.ID] {
+				seen[event.ID] = true
+				idx.eventIds = append(idx.eventIds, event.ID)
+			}
+		}
+		if !found {
+			continue
+		}
+		idx.contracts[contract.Address] = contract
+		idx.addresses = append(idx.addresses, contract.Address)
+		if first || contract.StartBlock < idx.startBlock {
+			idx.startBlock = contract.StartBlock
+			first = false
+		}
+	}
+	if len(idx.addresses) == 0 {
+		return nil, errors.New("Indexer: No watched contract is deployed")
+	}
+	return idx, nil
+}
+
+func (idx *Indexer) Store() *Store {
+	return idx.store
+}
+
+// Watched reports whether the events of a contract are indexed, any of them when event is empty
+func (idx *Indexer) Watched(contract string, event string) bool {
+	if event == "" {
+		return len(idx.watched[contract]) > 0
+	}
+	return idx.watched[contract][event]
+}
+
+// Finalized returns the last block with the confirmation depth of the chain, as of the last sync.
+// ok is false until the chain is that deep
+func (idx *Indexer) Finalized() (blockNumber uint64, ok bool) {
+	idx.headMu.Lock()
+	defer idx.headMu.Unlock()
+	if idx.head+1 < idx.confirmations {
+		return 0, false
+	}
+	return idx.head + 1 - idx.confirmations, true
+}
+
+func (idx *Indexer) setHead(head uint64) {
+	idx.headMu.Lock()
+	defer idx.headMu.Unlock()
+	idx.head = head
+}
+
+// windowStart is the first block whose hash is kept when the chain is at head
+func (idx *Indexer) windowStart(head uint64) uint64 {
+	window := uint64(REORG_WINDOW)
+	if idx.confirmations > window {
+		window = idx.confirmations
+	}
+	if head < window {
+		return 0
+	}
+	return head - window
+}
+
+// Run syncs until ctx is done, waiting pollInterval once caught up with the chain
+func (idx *Indexer) Run(ctx context.Context) {
+	for {
+		caughtUp, err := idx.Sync(ctx)
+		if err != nil {
+			log.Printf("Indexer: %v", err)
+		}
+		if caughtUp || err != nil {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(idx.pollInterval):
+			}
+		} else if ctx.Err() != nil {
+			return
+		}
+	}
+}
+
+// Sync indexes the next range of at most LogRange blocks and reports whether the head was reached.
+// When the chain no longer extends the last indexed block, it rolls back to the fork point instead
+func (idx *Indexer) Sync(ctx context.Context) (bool, error) {
+	cursor, ok, err := idx.store.Cursor()
+	if err != nil {
+		return false, err
+	}
+	head, err := idx.config.Client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return false, &utils.RpcError{Method: "eth_getBlockByNumber", Err: err}
+	}
+	headNumber := head.Number.Uint64()
+	idx.setHead(headNumber)
+
+	from := idx.startBlock
+	if ok {
+		// A node behind the one we indexed from, wait for it
+		if cursor.Number > headNumber {
+			return true, nil
+		}
+		reorged, err := idx.rollbackReorg(ctx, cursor, headNumber)
+		if err != nil || reorged {
+			return false, err
+		}
+		from = cursor.Number + 1
+	}
+	if from > headNumber {
+		return true, nil
+	}
+	logRange := idx.config.LogRange
+	if logRange == 0 {
+		logRange = utils.DEFAULT_LOG_RANGE
+	}
+	to := from + logRange - 1
+	if to > headNumber {
+		to = headNumber
+	}
+
+	logs, err := idx.config.Client.FilterLogs(ctx, ethereum.FilterQuery{
+		FromBlock: new(big.Int).SetUint64(from),
+		ToBlock:   new(big.Int).SetUint64(to),
+		Addresses: idx.addresses,
+		Topics:    [][]common.Hash{idx.eventIds},
+	})
+	if err != nil {
+		return false, &utils.RpcError{Method: "eth_getLogs", Err: err}
+	}
+
+	// Record the hashes of the blocks a reorg can still replace, they must chain up to the cursor
+	windowStart := idx.windowStart(headNumber)
+	if from > windowStart {
+		windowStart = from
+	}
+	var blocks []Cursor
+	hashes := make(map[uint64]common.Hash)
+	parent := cursor.Hash
+	for number := windowStart; number <= to; number++ {
+		header, err := idx.config.Client.HeaderByNumber(ctx, new(big.Int).SetUint64(number))
+		if err != nil {
+			return false, &utils.RpcError{Method: "eth_getBlockByNumber", Err: err}
+		}
+		if (number > windowStart || (ok && number == from)) && header.ParentHash != parent {
+			// The chain changed while reading it, the next sync finds the fork point
+			return false, nil
+		}
+		parent = header.Hash()
+		blocks = append(blocks, Cursor{Number: number, Hash: parent})
+		hashes[number] = parent
+	}
+	toHash, recorded := hashes[to]
+	if !recorded {
+		header, err := idx.config.Client.HeaderByNumber(ctx, new(big.Int).SetUint64(to))
+		if err != nil {
+			return false, &utils.RpcError{Method: "eth_getBlockByNumber", Err: err}
+		}
+		toHash = header.Hash()
+	}
+
+	events := make([]IndexedEvent, 0, len(logs))
+	for _, log := range logs {
+		if hash, ok := hashes[log.BlockNumber]; ok && hash != log.BlockHash {
+			return false, nil
+		}
+		contract, ok := idx.contracts[log.Address]
+		if !ok {
+			continue
+		}
+		event, err := decodeLog(contract, log)
+		if err != nil {
+			return false, err
+		}
+		events = append(events, event)
+	}
+
+	err = idx.store.PutRange(events, blocks, Cursor{Number: to, Hash: toHash})
+	if err != nil {
+		return false, fmt.Errorf("Indexer: Cannot store blocks %d to %d: %v", from, to, err)
+	}
+	err = idx.store.PruneHashes(idx.windowStart(headNumber))
+	if err != nil {
+		return false, fmt.Errorf("Indexer: Cannot prune block hashes: %v", err)
+	}
+	notifications := make([]Notification, 0, len(events))
+	for _, event := range events {
+		notifications = append(notifications, Notification{Event: event})
+	}
+	idx.notify(notifications)
+	return to == headNumber, nil
+}
+
+// rollbackReorg checks that the chain still extends cursor. If not, it rolls the store back to the
+// last recorded block still in the chain and notifies the removed events, newest first
+func (idx *Indexer) rollbackReorg(ctx context.Context, cursor Cursor, headNumber uint64) (bool, error) {
+	var changed bool
+	if cursor.Number < headNumber {
+		next, err := idx.config.Client.HeaderByNumber(ctx, new(big.Int).SetUint64(cursor.Number+1))
+		if err != nil {
+			return false, &utils.RpcError{Method: "eth_getBlockByNumber", Err: err}
+		}
+		changed = next.ParentHash != cursor.Hash
+	} else {
+		current, err := idx.config.Client.HeaderByNumber(ctx, new(big.Int).SetUint64(cursor.Number))
+		if err != nil {
+			return false, &utils.RpcError{Method: "eth_getBlockByNumber", Err: err}
+		}
+		changed = current.Hash() != cursor.Hash
+	}
+	if !changed {
+		return false, nil
+	}
+
+	fork, err := idx.findFork(ctx, cursor.Number)
+	if err != nil {
+		return false, err
+	}
+	removed, err := idx.store.Rollback(fork)
+	if err != nil {
+		return false, fmt.Errorf("Indexer: Cannot roll back to block %d: %v", fork.Number, err)
+	}
+	log.Printf("Indexer: Reorg below block %d, rolled back to block %d and removed %d events", cursor.Number, fork.Number, len(removed))
+	notifications := make([]Notification, 0, len(removed))
+	for i := len(removed) - 1; i >= 0; i-- {
+		notifications = append(notifications, Notification{Event: removed[i], Removed: true})
+	}
+	idx.notify(notifications)
+	return true, nil
+}
+
+// findFork walks back the recorded block hashes below blockNumber to the last one still in the chain
+func (idx *Indexer) findFork(ctx context.Context, blockNumber uint64) (Cursor, error) {
+	for number := blockNumber; number > 0; {
+		number--
+		hash, ok, err := idx.store.BlockHash(number)
+		if err != nil {
+			return Cursor{}, err
+		}
+		if !ok {
+			return Cursor{}, fmt.Errorf("Indexer: Reorg deeper than the recorded blocks, block %d is not recorded", number)
+		}
+		header, err := idx.config.Client.HeaderByNumber(ctx, new(big.Int).SetUint64(number))
+		if err != nil {
+			return Cursor{}, &utils.RpcError{Method: "eth_getBlockByNumber", Err: err}
+		}
+		if header.Hash() == hash {
+			return Cursor{Number: number, Hash: hash}, nil
+		}
+	}
+	return Cursor{}, errors.New("Indexer: Reorg down to the genesis block")
+}
+
+func decodeLog(contract utils.Contract, log types.Log) (IndexedEvent, error) {
+	event, err := utils.DecodeEvent(contract, log)
+	if err != nil {
+		return IndexedEvent{}, err
+	}
+	return IndexedEvent{
+		Contract:    contract.Name,
+		Name:        event.Name,
+		BlockNumber: log.BlockNumber,
+		BlockHash:   log.BlockHash,
+		TxHash:      log.TxHash,
+		TxIndex:     log.TxIndex,
+		LogIndex:    log.Index,
+		Args:        formatArgs(event.Args),
+	}, nil
+}
+
+// formatArgs turns decoded event arguments into strings that survive a JSON round trip
+func formatArgs(args map[string]interface{}) map[string]string {
+	formatted := make(map[string]string, len(args))
+	for name, value := range args {
+		switch v := value.(type) {
+		case *big.Int:
+			formatted[name] = v.String()
+		case common.Address:
+			formatted[name] = v.Hex()
+		case common.Hash:
+			formatted[name] = v.Hex()
+		case [32]byte:
+			formatted[name] = hexutil.Encode(v[:])
+		case []byte:
+			formatted[name] = hexutil.Encode(v)
+		case bool:
+			formatted[name] = strconv.FormatBool(v)
+		case string:
+			formatted[name] = v
+		default:
+			formatted[name] = fmt.Sprint(v)
+		}
+	}
+	return formatted
+}
@@ -0,0 +1,206 @@
+package indexer
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/mineloop99/new-token/back_end/utils"
+	"github.com/mineloop99/new-token/back_end/utils/configtest"
+	"github.com/mineloop99/new-token/back_end/utils/utilstest"
+)
+
+type testChain struct {
+	*utilstest.Chain
+	config utils.Config
+}
+
+func newTestChain(t *testing.T) *testChain {
+	t.Helper()
+	chain := &testChain{Chain: utilstest.NewChain(t)}
+	token := configtest.Deploy(t, chain.Chain, utils.ANIWAR_TOKEN)
+	pool := configtest.Deploy(t, chain.Chain, utils.ANIWAR_POOL, token.Address, big.NewInt(100), big.NewInt(0))
+	chain.config = configtest.New(chain.Chain, token, pool)
+	return chain
+}
+
+func (c *testChain) transact(t *testing.T, contractName string, method string, args ...interface{}) {
+	t.Helper()
+	contract := c.config.Contracts[contractName]
+	c.Transact(t, contract.Address, contract.ABI, method, args...)
+}
+
+func syncAll(t *testing.T, idx *Indexer) {
+	t.Helper()
+	for i := 0; i < 100; i++ {
+		caughtUp, err := idx.Sync(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if caughtUp {
+			return
+		}
+	}
+	t.Fatal("indexer did not catch up")
+}
+
+func storedEvents(t *testing.T, store *Store) []IndexedEvent {
+	t.Helper()
+	var events []IndexedEvent
+	err := store.Events(0, func(event IndexedEvent) bool {
+		events = append(events, event)
+		return true
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return events
+}
+
+func TestIndexerStoresDecodedEvents(t *testing.T) {
+	chain := newTestChain(t)
+	// small ranges so the sync takes several steps
+	chain.config.LogRange = 2
+	recipient := common.HexToAddress("0x0000000000000000000000000000000000000007")
+	pool := chain.config.Contracts[utils.ANIWAR_POOL].Address
+	chain.transact(t, utils.ANIWAR_TOKEN, "transfer", recipient, big.NewInt(5))
+	chain.transact(t, utils.ANIWAR_TOKEN, "approve", pool, big.NewInt(50))
+	chain.transact(t, utils.ANIWAR_POOL, "enterStaking", big.NewInt(50))
+
+	idx, err := New(chain.config, NewMemoryStore(), DEFAULT_WATCHES)
+	if err != nil {
+		t.Fatal(err)
+	}
+	syncAll(t, idx)
+
+	var transfers, stakes []IndexedEvent
+	for _, event := range storedEvents(t, idx.Store()) {
+		switch event.Contract + "." + event.Name {
+		case "AniwarToken.Transfer":
+			transfers = append(transfers, event)
+		case "AniwarPool.EnterStaking":
+			stakes = append(stakes, event)
+		default:
+			t.Errorf("unexpected event %s.%s", event.Contract, event.Name)
+		}
+	}
+	// the mint of the deployment, the transfer and the stake
+	if len(transfers) != 3 {
+		t.Fatalf("got %d transfers, want 3", len(transfers))
+	}
+	if transfers[1].Args["to"] != recipient.Hex() || transfers[1].Args["value"] != "5" {
+		t.Errorf("transfer args = %v", transfers[1].Args)
+	}
+	if len(stakes) != 1 || stakes[0].Args["user"] != chain.Auth.From.Hex() || stakes[0].Args["amount"] != "50" {
+		t.Fatalf("stakes = %v", stakes)
+	}
+	if stakes[0].TxHash == (common.Hash{}) || stakes[0].BlockNumber == 0 {
+		t.Errorf("stake is missing its position: %+v", stakes[0])
+	}
+}
+
+func TestIndexerIsIdempotent(t *testing.T) {
+	chain := newTestChain(t)
+	recipient := common.HexToAddress("0x0000000000000000000000000000000000000007")
+	chain.transact(t, utils.ANIWAR_TOKEN, "transfer", recipient, big.NewInt(5))
+
+	store, err := OpenStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close()
+	idx, err := New(chain.config, store, DEFAULT_WATCHES)
+	if err != nil {
+		t.Fatal(err)
+	}
+	syncAll(t, idx)
+	want := len(storedEvents(t, store))
+
+	// a crash after the write but before the cursor moved replays the range
+	genesis, err := chain.HeaderByNumber(context.Background(), big.NewInt(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := store.PutRange(nil, nil, Cursor{Number: 0, Hash: genesis.Hash()}); err != nil {
+		t.Fatal(err)
+	}
+	restarted, err := New(chain.config, store, DEFAULT_WATCHES)
+	if err != nil {
+		t.Fatal(err)
+	}
+	syncAll(t, restarted)
+	if got := len(storedEvents(t, store)); got != want {
+		t.Errorf("got %d events after the replay, want %d", got, want)
+	}
+
+	chain.transact(t, utils.ANIWAR_TOKEN, "transfer", recipient, big.NewInt(6))
+	syncAll(t, restarted)
+	if got := len(storedEvents(t, store)); got != want+1 {
+		t.Errorf("got %d events after a new transfer, want %d", got, want+1)
+	}
+	cursor, ok, err := store.Cursor()
+	if err != nil || !ok {
+		t.Fatalf("cursor = %v, %v", ok, err)
+	}
+	head, _ := chain.HeaderByNumber(context.Background(), nil)
+	if cursor.Number != head.Number.Uint64() || cursor.Hash != head.Hash() {
+		t.Errorf("cursor = %+v, want block %d %s", cursor, head.Number, head.Hash().Hex())
+	}
+}
+
+func TestIndexerRollsBackReorg(t *testing.T) {
+	chain := newTestChain(t)
+	ctx := context.Background()
+	idx, err := New(chain.config, NewMemoryStore(), DEFAULT_WATCHES)
+	if err != nil {
+		t.Fatal(err)
+	}
+	syncAll(t, idx)
+	sub := idx.Subscribe(10)
+	defer sub.Unsubscribe()
+
+	forkPoint, err := chain.HeaderByNumber(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	replaced := common.HexToAddress("0x0000000000000000000000000000000000000007")
+	chain.transact(t, utils.ANIWAR_TOKEN, "transfer", replaced, big.NewInt(5))
+	syncAll(t, idx)
+
+	// a longer chain from the fork point without the first transfer
+	if err := chain.Fork(ctx, forkPoint.Hash()); err != nil {
+		t.Fatal(err)
+	}
+	kept := common.HexToAddress("0x0000000000000000000000000000000000000008")
+	chain.transact(t, utils.ANIWAR_TOKEN, "transfer", kept, big.NewInt(6))
+	chain.Commit()
+	syncAll(t, idx)
+
+	for _, event := range storedEvents(t, idx.Store()) {
+		if event.Args["to"] == replaced.Hex() {
+			t.Errorf("replaced transfer still stored: %+v", event)
+		}
+	}
+	var notifications []Notification
+	for len(sub.Notifications()) > 0 {
+		notifications = append(notifications, <-sub.Notifications())
+	}
+	if len(notifications) != 3 {
+		t.Fatalf("got %d notifications, want 3: %+v", len(notifications), notifications)
+	}
+	want := []struct {
+		to      common.Address
+		removed bool
+	}{{replaced, false}, {replaced, true}, {kept, false}}
+	for i, w := range want {
+		if notifications[i].Event.Args["to"] != w.to.Hex() || notifications[i].Removed != w.removed {
+			t.Errorf("notification %d = %+v, want to %s removed %v", i, notifications[i], w.to.Hex(), w.removed)
+		}
+	}
+	cursor, _, _ := idx.Store().Cursor()
+	head, _ := chain.HeaderByNumber(ctx, nil)
+	if cursor.Hash != head.Hash() {
+		t.Errorf("cursor = %+v, want head %s", cursor, head.Hash().Hex())
+	}
+}
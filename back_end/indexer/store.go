@@ -0,0 +1,206 @@
+package indexer
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/ethdb/leveldb"
+	"github.com/ethereum/go-ethereum/ethdb/memorydb"
+)
+
+var (
+	// eventPrefix + block number (8 bytes) + log index (4 bytes) -> IndexedEvent JSON
+	eventPrefix = []byte("e")
+	// hashPrefix + block number (8 bytes) -> block hash, kept for the blocks a reorg can still replace
+	hashPrefix = []byte("h")
+	cursorKey  = []byte("cursor")
+)
+
+// IndexedEvent is a decoded contract event as stored by the indexer
+type IndexedEvent struct {
+	Contract    string      `json:"contract"`
+	Name        string      `json:"name"`
+	BlockNumber uint64      `json:"blockNumber"`
+	BlockHash   common.Hash `json:"blockHash"`
+	TxHash      common.Hash `json:"txHash"`
+	TxIndex     uint        `json:"txIndex"`
+	LogIndex    uint        `json:"logIndex"`
+	// Arguments by ABI name, numbers in base 10 and addresses, hashes and bytes in 0x hex
+	Args map[string]string `json:"args"`
+}
+
+// Cursor is the last block the indexer has stored the events of
+type Cursor struct {
+	Number uint64      `json:"number"`
+	Hash   common.Hash `json:"hash"`
+}
+
+// Store persists the indexed events in a key-value database, keyed by block number and log index
+type Store struct {
+	db ethdb.KeyValueStore
+}
+
+// OpenStore opens or creates a LevelDB store in dir
+func OpenStore(dir string) (*Store, error) {
+	db, err := leveldb.New(dir, 16, 16, "indexer/", false)
+	if err != nil {
+		return nil, fmt.Errorf("Indexer: Cannot open store %s: %v", dir, err)
+	}
+	return &Store{db: db}, nil
+}
+
+// NewMemoryStore returns a store that is lost on exit, for tests
+func NewMemoryStore() *Store {
+	return &Store{db: memorydb.New()}
+}
+
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+func hashKey(blockNumber uint64) []byte {
+	key := make([]byte, len(hashPrefix)+8)
+	copy(key, hashPrefix)
+	binary.BigEndian.PutUint64(key[len(hashPrefix):], blockNumber)
+	return key
+}
+
+func eventKey(blockNumber uint64, logIndex uint) []byte {
+	key := make([]byte, len(eventPrefix)+12)
+	copy(key, eventPrefix)
+	binary.BigEndian.PutUint64(key[len(eventPrefix):], blockNumber)
+	binary.BigEndian.PutUint32(key[len(eventPrefix)+8:], uint32(logIndex))
+	return key
+}
+
+// Cursor returns the last indexed block, ok is false before the first block is indexed
+func (s *Store) Cursor() (cursor Cursor, ok bool, err error) {
+	// ethdb reports missing keys as errors
+	has, err := s.db.Has(cursorKey)
+	if err != nil || !has {
+		return Cursor{}, false, err
+	}
+	data, err := s.db.Get(cursorKey)
+	if err != nil {
+		return Cursor{}, false, err
+	}
+	err = json.Unmarshal(data, &cursor)
+	return cursor, err == nil, err
+}
+
+// PutRange stores the events and block hashes of a block range and moves the cursor to its end in one write.
+// Writing the same range again overwrites the same keys, so a restart after a crash is harmless
+func (s *Store) PutRange(events []IndexedEvent, blocks []Cursor, cursor Cursor) error {
+	batch := s.db.NewBatch()
+	for _, event := range events {
+		value, err := json.Marshal(event)
+		if err != nil {
+			return err
+		}
+		if err := batch.Put(eventKey(event.BlockNumber, event.LogIndex), value); err != nil {
+			return err
+		}
+	}
+	for _, block := range blocks {
+		if err := batch.Put(hashKey(block.Number), block.Hash.Bytes()); err != nil {
+			return err
+		}
+	}
+	if err := putCursor(batch, cursor); err != nil {
+		return err
+	}
+	return batch.Write()
+}
+
+func putCursor(batch ethdb.Batch, cursor Cursor) error {
+	value, err := json.Marshal(cursor)
+	if err != nil {
+		return err
+	}
+	return batch.Put(cursorKey, value)
+}
+
+// BlockHash returns the recorded hash of a block, ok is false when it was pruned or never recorded
+func (s *Store) BlockHash(blockNumber uint64) (hash common.Hash, ok bool, err error) {
+	has, err := s.db.Has(hashKey(blockNumber))
+	if err != nil || !has {
+		return common.Hash{}, false, err
+	}
+	data, err := s.db.Get(hashKey(blockNumber))
+	if err != nil {
+		return common.Hash{}, false, err
+	}
+	return common.BytesToHash(data), true, nil
+}
+
+// Rollback deletes the events and block hashes after fork and moves the cursor back to it in one write.
+// It returns the deleted events in chain order
+func (s *Store) Rollback(fork Cursor) ([]IndexedEvent, error) {
+	var removed []IndexedEvent
+	err := s.Events(fork.Number+1, func(event IndexedEvent) bool {
+		removed = append(removed, event)
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	batch := s.db.NewBatch()
+	for _, event := range removed {
+		if err := batch.Delete(eventKey(event.BlockNumber, event.LogIndex)); err != nil {
+			return nil, err
+		}
+	}
+	it := s.db.NewIterator(hashPrefix, hashKey(fork.Number + 1)[len(hashPrefix):])
+	for it.Next() {
+		if err := batch.Delete(common.CopyBytes(it.Key())); err != nil {
+			it.Release()
+			return nil, err
+		}
+	}
+	it.Release()
+	if err := it.Error(); err != nil {
+		return nil, err
+	}
+	if err := putCursor(batch, fork); err != nil {
+		return nil, err
+	}
+	return removed, batch.Write()
+}
+
+// PruneHashes forgets the hashes of the blocks below blockNumber, they are final
+func (s *Store) PruneHashes(blockNumber uint64) error {
+	batch := s.db.NewBatch()
+	it := s.db.NewIterator(hashPrefix, nil)
+	defer it.Release()
+	for it.Next() {
+		if binary.BigEndian.Uint64(it.Key()[len(hashPrefix):]) >= blockNumber {
+			break
+		}
+		if err := batch.Delete(common.CopyBytes(it.Key())); err != nil {
+			return err
+		}
+	}
+	if err := it.Error(); err != nil {
+		return err
+	}
+	return batch.Write()
+}
+
+// Events calls fn with the stored events from fromBlock on, in chain order, until fn returns false
+func (s *Store) Events(fromBlock uint64, fn func(IndexedEvent) bool) error {
+	it := s.db.NewIterator(eventPrefix, eventKey(fromBlock, 0)[len(eventPrefix):])
+	defer it.Release()
+	for it.Next() {
+		var event IndexedEvent
+		if err := json.Unmarshal(it.Value(), &event); err != nil {
+			return fmt.Errorf("Indexer: Corrupted event %x: %v", it.Key(), err)
+		}
+		if !fn(event) {
+			break
+		}
+	}
+	return it.Error()
+}
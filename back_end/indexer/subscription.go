@@ -0,0 +1,86 @@
+package indexer
+
+import (
+	"errors"
+	"sync"
+)
+
+var ErrSlowConsumer = errors.New("subscriber is not reading its notifications fast enough")
+
+// Notification is sent to subscribers for every stored event, and again with Removed set when a
+// reorg drops the event from the chain
+type Notification struct {
+	Event   IndexedEvent
+	Removed bool
+}
+
+// Subscription receives the notifications of the indexer. The indexer never waits for a subscriber:
+// when its buffer is full the subscription is closed with ErrSlowConsumer
+type Subscription struct {
+	ch   chan Notification
+	once sync.Once
+	mu   sync.Mutex
+	err  error
+	idx  *Indexer
+}
+
+// Notifications is closed when the subscription ends, Err then tells why
+func (s *Subscription) Notifications() <-chan Notification {
+	return s.ch
+}
+
+// Err returns ErrSlowConsumer when the indexer closed the subscription, nil otherwise
+func (s *Subscription) Err() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.err
+}
+
+func (s *Subscription) Unsubscribe() {
+	s.idx.removeSubscription(s, nil)
+}
+
+func (s *Subscription) close(err error) {
+	s.once.Do(func() {
+		s.mu.Lock()
+		s.err = err
+		s.mu.Unlock()
+		close(s.ch)
+	})
+}
+
+// Subscribe returns a subscription to the notifications of the next synced blocks
+func (idx *Indexer) Subscribe(buffer int) *Subscription {
+	sub := &Subscription{ch: make(chan Notification, buffer), idx: idx}
+	idx.subsMu.Lock()
+	defer idx.subsMu.Unlock()
+	idx.subs[sub] = struct{}{}
+	return sub
+}
+
+func (idx *Indexer) removeSubscription(sub *Subscription, err error) {
+	idx.subsMu.Lock()
+	delete(idx.subs, sub)
+	idx.subsMu.Unlock()
+	sub.close(err)
+}
+
+func (idx *Indexer) notify(notifications []Notification) {
+	if len(notifications) == 0 {
+		return
+	}
+	idx.subsMu.Lock()
+	defer idx.subsMu.Unlock()
+	for sub := range idx.subs {
+		for _, notification := range notifications {
+			select {
+			case sub.ch <- notification:
+				continue
+			default:
+			}
+			delete(idx.subs, sub)
+			sub.close(ErrSlowConsumer)
+			break
+		}
+	}
+}
@@ -3,6 +3,8 @@ package main
 import (
 	"log"
 
+	"github.com/mineloop99/new-token/back_end/indexer"
+	"github.com/mineloop99/new-token/back_end/rewards"
 	"github.com/mineloop99/new-token/back_end/server"
 	"github.com/mineloop99/new-token/back_end/utils"
 )
@@ -15,5 +17,14 @@ func main() {
 	if err != nil {
 		log.Fatalf("Failed to init config: %v", err)
 	}
+	config, _ := utils.GetConfig()
+	err = indexer.InitIndexer(config)
+	if err != nil {
+		log.Fatalf("Failed to init indexer: %v", err)
+	}
+	err = rewards.InitRewards(config)
+	if err != nil {
+		log.Fatalf("Failed to init rewards: %v", err)
+	}
 	server.InitServer(host, port)
 }
@@ -0,0 +1,364 @@
+package rewards
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const SOURCE_COMMIT_REVEAL = "commit-reveal"
+
+const DEFAULT_ROUND_DURATION = time.Hour
+const DEFAULT_ROUND_DRAWS = 1000
+
+// A draw picks one of at most this many weighted outcomes
+const MAX_DRAW_OUTCOMES = 100
+
+var (
+	// roundPrefix + round id (8 bytes) -> Round JSON
+	roundPrefix = []byte("n")
+	// drawPrefix + request id -> Draw JSON
+	drawPrefix      = []byte("d")
+	currentRoundKey = []byte("round")
+)
+
+var (
+	ErrInvalidDraw = errors.New("draw weights are invalid")
+	// The request id was drawn before with other weights
+	ErrDrawConflict = errors.New("request id is already drawn with other weights")
+	// The seed does not match the committed seed hash
+	ErrSeedMismatch = errors.New("seed does not match the seed hash")
+)
+
+// RandomnessSource provides the seed of a draw round. Commit runs when the round opens and returns
+// the seed hash published before any draw. Chainlink VRF can be plugged in as a source whose Seed
+// reports ok false until the coordinator fulfilled the request of the round
+type RandomnessSource interface {
+	Name() string
+	Commit(ctx context.Context) (seedHash common.Hash, secret []byte, err error)
+	// Seed returns the seed of a round from the secret kept by Commit
+	Seed(ctx context.Context, seedHash common.Hash, secret []byte) (seed []byte, ok bool, err error)
+}
+
+// CommitRevealSource draws the seed from crypto/rand and commits to its keccak256 hash
+type CommitRevealSource struct{}
+
+func (CommitRevealSource) Name() string {
+	return SOURCE_COMMIT_REVEAL
+}
+
+func (CommitRevealSource) Commit(ctx context.Context) (common.Hash, []byte, error) {
+	seed := make([]byte, 32)
+	if _, err := rand.Read(seed); err != nil {
+		return common.Hash{}, nil, err
+	}
+	return crypto.Keccak256Hash(seed), seed, nil
+}
+
+func (CommitRevealSource) Seed(ctx context.Context, seedHash common.Hash, secret []byte) ([]byte, bool, error) {
+	return secret, true, nil
+}
+
+// Round is a seed commitment shared by the draws made while it is open. The seed is published
+// once the round is closed
+type Round struct {
+	Id       uint64      `json:"id"`
+	Source   string      `json:"source"`
+	SeedHash common.Hash `json:"seedHash"`
+	// Kept secret until Revealed
+	Secret    []byte `json:"secret"`
+	Revealed  bool   `json:"revealed"`
+	Draws     uint64 `json:"draws"`
+	OpenedAt  int64  `json:"openedAt"`
+	ClosedAt  int64  `json:"closedAt,omitempty"`
+	ExpiresAt int64  `json:"expiresAt"`
+}
+
+// Draw is the outcome of a request id, drawn once
+type Draw struct {
+	RequestId string   `json:"requestId"`
+	Round     uint64   `json:"round"`
+	Weights   []uint64 `json:"weights"`
+	Number    *big.Int `json:"number"`
+	Outcome   int      `json:"outcome"`
+	CreatedAt int64    `json:"createdAt"`
+}
+
+// DrawNumber is keccak256(seed || request id) read as a big-endian integer
+func DrawNumber(seed []byte, requestId string) *big.Int {
+	return new(big.Int).SetBytes(crypto.Keccak256(seed, []byte(requestId)))
+}
+
+// PickOutcome returns the index of weights that number falls in, number modulo the total weight
+func PickOutcome(number *big.Int, weights []uint64) (int, error) {
+	if len(weights) == 0 || len(weights) > MAX_DRAW_OUTCOMES {
+		return 0, fmt.Errorf("%w: between 1 and %d weights are required, got %d", ErrInvalidDraw, MAX_DRAW_OUTCOMES, len(weights))
+	}
+	total := new(big.Int)
+	for _, weight := range weights {
+		total.Add(total, new(big.Int).SetUint64(weight))
+	}
+	if total.Sign() == 0 {
+		return 0, fmt.Errorf("%w: the weights sum to 0", ErrInvalidDraw)
+	}
+	roll := new(big.Int).Mod(number, total)
+	for i, weight := range weights {
+		w := new(big.Int).SetUint64(weight)
+		if roll.Cmp(w) < 0 {
+			return i, nil
+		}
+		roll.Sub(roll, w)
+	}
+	// unreachable, roll < total
+	return len(weights) - 1, nil
+}
+
+// VerifyDraw recomputes a commit-reveal draw from the revealed seed
+func VerifyDraw(seed []byte, seedHash common.Hash, requestId string, weights []uint64) (*big.Int, int, error) {
+	if crypto.Keccak256Hash(seed) != seedHash {
+		return nil, 0, ErrSeedMismatch
+	}
+	number := DrawNumber(seed, requestId)
+	outcome, err := PickOutcome(number, weights)
+	if err != nil {
+		return nil, 0, err
+	}
+	return number, outcome, nil
+}
+
+// Drawer makes the draws of the rounds it opens, closes and reveals
+type Drawer struct {
+	ledger   *Ledger
+	source   RandomnessSource
+	duration time.Duration
+	maxDraws uint64
+
+	mu sync.Mutex
+}
+
+func NewDrawer(ledger *Ledger, source RandomnessSource, duration time.Duration, maxDraws uint64) *Drawer {
+	if duration <= 0 {
+		duration = DEFAULT_ROUND_DURATION
+	}
+	if maxDraws == 0 {
+		maxDraws = DEFAULT_ROUND_DRAWS
+	}
+	return &Drawer{ledger: ledger, source: source, duration: duration, maxDraws: maxDraws}
+}
+
+// Run closes and reveals the expired rounds until ctx is done, a round nobody draws in is revealed too
+func (d *Drawer) Run(ctx context.Context) {
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+	for {
+		if _, err := d.CurrentRound(ctx); err != nil {
+			log.Printf("Rewards: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// CurrentRound returns the open round, closing the current one and opening the next when it is
+// expired or full
+func (d *Drawer) CurrentRound(ctx context.Context) (Round, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.currentRound(ctx)
+}
+
+func (d *Drawer) currentRound(ctx context.Context) (Round, error) {
+	round, ok, err := d.ledger.currentRound()
+	if err != nil {
+		return Round{}, err
+	}
+	now := time.Now()
+	if ok && now.Unix() < round.ExpiresAt && round.Draws < d.maxDraws {
+		return round, nil
+	}
+
+	seedHash, secret, err := d.source.Commit(ctx)
+	if err != nil {
+		return Round{}, fmt.Errorf("Rewards: Cannot commit to a seed: %v", err)
+	}
+	next := Round{
+		// Round ids start at 1, 0 stands for the open round in RewardService
+		Id:        1,
+		Source:    d.source.Name(),
+		SeedHash:  seedHash,
+		Secret:    secret,
+		OpenedAt:  now.Unix(),
+		ExpiresAt: now.Add(d.duration).Unix(),
+	}
+	var closed *Round
+	if ok {
+		next.Id = round.Id + 1
+		round.Revealed = true
+		round.ClosedAt = now.Unix()
+		closed = &round
+	}
+	if err := d.ledger.putRounds(closed, next); err != nil {
+		return Round{}, fmt.Errorf("Rewards: Cannot store round %d: %v", next.Id, err)
+	}
+	return next, nil
+}
+
+// Draw draws the outcome of requestId in the open round. Drawing a request id again returns the stored
+// draw with created false, or ErrDrawConflict when the weights differ
+func (d *Drawer) Draw(ctx context.Context, requestId string, weights []uint64) (draw Draw, created bool, err error) {
+	if _, err := PickOutcome(new(big.Int), weights); err != nil {
+		return Draw{}, false, err
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	existing, ok, err := d.ledger.GetDraw(requestId)
+	if err != nil {
+		return Draw{}, false, err
+	}
+	if ok {
+		if !sameWeights(existing.Weights, weights) {
+			return existing, false, ErrDrawConflict
+		}
+		return existing, false, nil
+	}
+
+	round, err := d.currentRound(ctx)
+	if err != nil {
+		return Draw{}, false, err
+	}
+	seed, ok, err := d.source.Seed(ctx, round.SeedHash, round.Secret)
+	if err != nil {
+		return Draw{}, false, fmt.Errorf("Rewards: Cannot get the seed of round %d: %v", round.Id, err)
+	}
+	if !ok {
+		return Draw{}, false, fmt.Errorf("Rewards: The seed of round %d is not available yet", round.Id)
+	}
+	draw = Draw{
+		RequestId: requestId,
+		Round:     round.Id,
+		Weights:   weights,
+		Number:    DrawNumber(seed, requestId),
+		CreatedAt: time.Now().Unix(),
+	}
+	draw.Outcome, _ = PickOutcome(draw.Number, weights)
+	round.Draws++
+	if err := d.ledger.putDraw(draw, round); err != nil {
+		return Draw{}, false, fmt.Errorf("Rewards: Cannot store draw %s: %v", requestId, err)
+	}
+	return draw, true, nil
+}
+
+func sameWeights(a []uint64, b []uint64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func roundKey(id uint64) []byte {
+	key := make([]byte, len(roundPrefix)+8)
+	copy(key, roundPrefix)
+	binary.BigEndian.PutUint64(key[len(roundPrefix):], id)
+	return key
+}
+
+func drawKey(requestId string) []byte {
+	return append(append([]byte{}, drawPrefix...), requestId...)
+}
+
+// getJSON reads the JSON value of key into value, ok is false when the key is missing
+func (l *Ledger) getJSON(key []byte, value interface{}) (ok bool, err error) {
+	// ethdb reports missing keys as errors
+	has, err := l.db.Has(key)
+	if err != nil || !has {
+		return false, err
+	}
+	data, err := l.db.Get(key)
+	if err != nil {
+		return false, err
+	}
+	return true, json.Unmarshal(data, value)
+}
+
+// GetRound returns a round, ok is false when it was never opened
+func (l *Ledger) GetRound(id uint64) (round Round, ok bool, err error) {
+	ok, err = l.getJSON(roundKey(id), &round)
+	return round, ok && err == nil, err
+}
+
+// GetDraw returns the draw of a request id, ok is false when it was never drawn
+func (l *Ledger) GetDraw(requestId string) (draw Draw, ok bool, err error) {
+	ok, err = l.getJSON(drawKey(requestId), &draw)
+	return draw, ok && err == nil, err
+}
+
+func (l *Ledger) currentRound() (round Round, ok bool, err error) {
+	has, err := l.db.Has(currentRoundKey)
+	if err != nil || !has {
+		return Round{}, false, err
+	}
+	data, err := l.db.Get(currentRoundKey)
+	if err != nil {
+		return Round{}, false, err
+	}
+	return l.GetRound(binary.BigEndian.Uint64(data))
+}
+
+// putRounds stores the closed round and makes next the current one in one write
+func (l *Ledger) putRounds(closed *Round, next Round) error {
+	batch := l.db.NewBatch()
+	for _, round := range []*Round{closed, &next} {
+		if round == nil {
+			continue
+		}
+		value, err := json.Marshal(round)
+		if err != nil {
+			return err
+		}
+		if err := batch.Put(roundKey(round.Id), value); err != nil {
+			return err
+		}
+	}
+	if err := batch.Put(currentRoundKey, roundKey(next.Id)[len(roundPrefix):]); err != nil {
+		return err
+	}
+	return batch.Write()
+}
+
+// putDraw stores a draw and the draw count of its round in one write
+func (l *Ledger) putDraw(draw Draw, round Round) error {
+	batch := l.db.NewBatch()
+	value, err := json.Marshal(draw)
+	if err != nil {
+		return err
+	}
+	if err := batch.Put(drawKey(draw.RequestId), value); err != nil {
+		return err
+	}
+	value, err = json.Marshal(round)
+	if err != nil {
+		return err
+	}
+	if err := batch.Put(roundKey(round.Id), value); err != nil {
+		return err
+	}
+	return batch.Write()
+}
@@ -0,0 +1,72 @@
+package rewards
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+)
+
+func TestDrawIsVerifiableAfterReveal(t *testing.T) {
+	ctx := context.Background()
+	// one draw per round, the second draw reveals the first round
+	drawer := NewDrawer(NewMemoryLedger(), CommitRevealSource{}, time.Hour, 1)
+	weights := []uint64{70, 25, 5}
+
+	round, err := drawer.CurrentRound(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	draw, created, err := drawer.Draw(ctx, "chest-1", weights)
+	if err != nil || !created {
+		t.Fatalf("created %v, err %v", created, err)
+	}
+	if draw.Round != round.Id {
+		t.Fatalf("drawn in round %d, committed round is %d", draw.Round, round.Id)
+	}
+	again, created, err := drawer.Draw(ctx, "chest-1", weights)
+	if err != nil || created || again.Number.Cmp(draw.Number) != 0 {
+		t.Fatalf("draw again: %+v, created %v, err %v", again, created, err)
+	}
+	if _, _, err := drawer.Draw(ctx, "chest-1", []uint64{1, 1}); !errors.Is(err, ErrDrawConflict) {
+		t.Fatalf("draw with other weights: err %v", err)
+	}
+
+	if _, _, err := drawer.Draw(ctx, "chest-2", weights); err != nil {
+		t.Fatal(err)
+	}
+	revealed, ok, err := drawer.ledger.GetRound(round.Id)
+	if err != nil || !ok || !revealed.Revealed {
+		t.Fatalf("round %+v, ok %v, err %v", revealed, ok, err)
+	}
+	number, outcome, err := VerifyDraw(revealed.Secret, round.SeedHash, "chest-1", weights)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if number.Cmp(draw.Number) != 0 || outcome != draw.Outcome {
+		t.Errorf("verified %v %d, drawn %v %d", number, outcome, draw.Number, draw.Outcome)
+	}
+	if _, _, err := VerifyDraw([]byte("forged"), round.SeedHash, "chest-1", weights); !errors.Is(err, ErrSeedMismatch) {
+		t.Errorf("forged seed: err %v", err)
+	}
+}
+
+func TestPickOutcome(t *testing.T) {
+	weights := []uint64{3, 0, 2}
+	for n, want := range []int{0, 0, 0, 2, 2, 0} {
+		got, err := PickOutcome(big.NewInt(int64(n)), weights)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != want {
+			t.Errorf("PickOutcome(%d) = %d, want %d", n, got, want)
+		}
+	}
+	if _, err := PickOutcome(big.NewInt(1), []uint64{0, 0}); !errors.Is(err, ErrInvalidDraw) {
+		t.Errorf("zero weights: err %v", err)
+	}
+	if _, err := PickOutcome(big.NewInt(1), nil); !errors.Is(err, ErrInvalidDraw) {
+		t.Errorf("no weights: err %v", err)
+	}
+}
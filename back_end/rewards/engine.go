@@ -0,0 +1,362 @@
+package rewards
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/mineloop99/new-token/back_end/utils"
+	"github.com/spf13/viper"
+)
+
+const DEFAULT_LEDGER_PATH = "./data/rewards"
+
+var (
+	ErrUnknownRule  = errors.New("reward rule is not configured")
+	ErrInvalidGrant = errors.New("grant does not fit the reward rule")
+	// The reward id was granted before to another player, rule or quantity
+	ErrRewardConflict = errors.New("reward id is already granted with other parameters")
+)
+
+// Engine grants rewards from the rules and pays them from the backend signer, one reward id at most once
+type Engine struct {
+	config       utils.Config
+	ledger       *Ledger
+	rules        map[string]Rule
+	pollInterval time.Duration
+	wake         chan struct{}
+	drawer       *Drawer
+	// Sequence of the oldest reward the queue may not be done with
+	next uint64
+}
+
+var engine *Engine
+
+// InitRewards starts the payout queue when rewards.enabled is set in config.yaml
+func InitRewards(config utils.Config) error {
+	if !viper.GetBool("rewards.enabled") {
+		return nil
+	}
+	rules, err := loadRules()
+	if err != nil {
+		return err
+	}
+	viper.SetDefault("rewards.path", DEFAULT_LEDGER_PATH)
+	ledger, err := OpenLedger(viper.GetString("rewards.path"))
+	if err != nil {
+		return err
+	}
+	engine = NewEngine(config, ledger, rules)
+	source, err := loadRandomnessSource()
+	if err != nil {
+		return err
+	}
+	engine.drawer = NewDrawer(ledger, source, viper.GetDuration("rewards.draws.roundDuration"), viper.GetUint64("rewards.draws.roundDraws"))
+	go engine.Run(context.Background())
+	go engine.drawer.Run(context.Background())
+	return nil
+}
+
+// loadRandomnessSource reads rewards.draws.source, commit-reveal when not set
+func loadRandomnessSource() (RandomnessSource, error) {
+	viper.SetDefault("rewards.draws.source", SOURCE_COMMIT_REVEAL)
+	switch name := viper.GetString("rewards.draws.source"); name {
+	case SOURCE_COMMIT_REVEAL:
+		return CommitRevealSource{}, nil
+	default:
+		return nil, fmt.Errorf("Config: rewards.draws.source: Unknown source %q", name)
+	}
+}
+
+// GetEngine returns the running engine, nil when rewards are disabled
+func GetEngine() *Engine {
+	return engine
+}
+
+// SetEngine replaces the engine GetEngine returns, the service tests run on a simulated chain
+func SetEngine(e *Engine) {
+	engine = e
+}
+
+func NewEngine(config utils.Config, ledger *Ledger, rules map[string]Rule) *Engine {
+	e := &Engine{
+		config:       config,
+		ledger:       ledger,
+		rules:        rules,
+		pollInterval: config.Tracker.PollInterval(),
+		wake:         make(chan struct{}, 1),
+		drawer:       NewDrawer(ledger, CommitRevealSource{}, 0, 0),
+	}
+	if e.pollInterval <= 0 {
+		e.pollInterval = time.Second * 3
+	}
+	return e
+}
+
+func (e *Engine) Ledger() *Ledger {
+	return e.ledger
+}
+
+func (e *Engine) Drawer() *Drawer {
+	return e.drawer
+}
+
+// Grant records a reward and queues its payout. Granting an id again returns the stored reward with
+// created false, or ErrRewardConflict when the player, rule or quantity differ
+func (e *Engine) Grant(id string, player common.Address, ruleName string, quantity uint64) (reward Reward, created bool, err error) {
+	rule, ok := e.rules[ruleName]
+	if !ok {
+		return Reward{}, false, fmt.Errorf("%s: %w", ruleName, ErrUnknownRule)
+	}
+	if quantity == 0 {
+		quantity = 1
+	}
+	entitlement, err := rule.Entitlement(quantity)
+	if err != nil {
+		return Reward{}, false, fmt.Errorf("%w: %v", ErrInvalidGrant, err)
+	}
+	now := time.Now().Unix()
+	reward = Reward{
+		Id:        id,
+		Player:    player,
+		Rule:      rule.Name,
+		Quantity:  quantity,
+		Kind:      entitlement.Kind,
+		Amount:    entitlement.Amount,
+		Items:     entitlement.Items,
+		Status:    STATUS_QUEUED,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	stored, created, err := e.ledger.Create(reward)
+	if err != nil {
+		return Reward{}, false, fmt.Errorf("Rewards: Cannot store %s: %v", id, err)
+	}
+	if !created && !stored.sameGrant(reward) {
+		return stored, false, ErrRewardConflict
+	}
+	if created {
+		select {
+		case e.wake <- struct{}{}:
+		default:
+		}
+	}
+	return stored, created, nil
+}
+
+// Run pays the queued rewards until ctx is done, on every grant and every pollInterval
+func (e *Engine) Run(ctx context.Context) {
+	if err := e.recoverInterrupted(); err != nil {
+		log.Printf("Rewards: %v", err)
+	}
+	for {
+		if err := e.processQueue(ctx); err != nil {
+			log.Printf("Rewards: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-e.wake:
+		case <-time.After(e.pollInterval):
+		}
+	}
+}
+
+// recoverInterrupted marks UNKNOWN the rewards a previous run stopped sending a transaction for.
+// The transaction may be on chain, sending it again could pay twice
+func (e *Engine) recoverInterrupted() error {
+	var interrupted []Reward
+	err := e.ledger.Rewards(0, func(reward Reward) bool {
+		if reward.Sending && !reward.Status.Final() {
+			interrupted = append(interrupted, reward)
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	for _, reward := range interrupted {
+		reward.Sending = false
+		reward.Status = STATUS_UNKNOWN
+		reward.Error = "The backend stopped while sending a transaction, check the signer account before paying again"
+		if err := e.update(reward); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// processQueue moves every pending reward one step, in grant order
+func (e *Engine) processQueue(ctx context.Context) error {
+	var pending []Reward
+	next := e.next
+	err := e.ledger.Rewards(e.next, func(reward Reward) bool {
+		if !reward.Status.Final() {
+			pending = append(pending, reward)
+		} else if len(pending) == 0 {
+			next = reward.Sequence + 1
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	e.next = next
+	for _, reward := range pending {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if err := e.process(ctx, reward); err != nil {
+			log.Printf("Rewards: %s: %v", reward.Id, err)
+		}
+	}
+	return nil
+}
+
+func (e *Engine) process(ctx context.Context, reward Reward) error {
+	switch reward.Status {
+	case STATUS_QUEUED:
+		return e.sendPayout(reward)
+	case STATUS_SENT:
+		return e.checkPayout(ctx, reward)
+	case STATUS_MINTED:
+		return e.transferItems(ctx, reward)
+	}
+	return nil
+}
+
+func (e *Engine) sendPayout(reward Reward) error {
+	reward.Sending = true
+	if err := e.update(reward); err != nil {
+		return err
+	}
+	var tx *types.Transaction
+	var err error
+	if reward.Kind == PAYOUT_ANI {
+		tx, err = utils.CallMethods(e.config, utils.ANIWAR_TOKEN, "transfer", big.NewInt(0), reward.Player, reward.Amount)
+	} else {
+		tx, err = utils.CallMethods(e.config, utils.ANIWAR_NFT, "createManyAniwarItem", big.NewInt(0), uint8(len(reward.Items)), reward.Items)
+	}
+	reward.Sending = false
+	if err != nil {
+		return e.fail(reward, err)
+	}
+	reward.TxHash = tx.Hash()
+	reward.Status = STATUS_SENT
+	return e.update(reward)
+}
+
+func (e *Engine) checkPayout(ctx context.Context, reward Reward) error {
+	state, err := e.config.Tracker.Status(ctx, reward.TxHash)
+	if errors.Is(err, utils.ErrTxNotFound) {
+		return e.unknown(reward, reward.TxHash)
+	}
+	if err != nil {
+		return err
+	}
+	switch state.Status {
+	case utils.TX_CONFIRMED:
+		if reward.Kind == PAYOUT_ANI {
+			reward.Status = STATUS_PAID
+			return e.update(reward)
+		}
+		nftContract, err := e.config.GetContract(utils.ANIWAR_NFT)
+		if err != nil {
+			return err
+		}
+		// requestedAniwarItem(uint256 indexed requestId, address requester) is emitted once per item
+		event := nftContract.ABI.Events["requestedAniwarItem"]
+		for _, log := range state.Receipt.Logs {
+			if log.Address != nftContract.Address || len(log.Topics) < 2 || log.Topics[0] != event.ID {
+				continue
+			}
+			reward.TokenIds = append(reward.TokenIds, log.Topics[1].Big())
+		}
+		reward.Status = STATUS_MINTED
+		return e.update(reward)
+	case utils.TX_REVERTED:
+		if state.RevertErr != nil {
+			return e.fail(reward, state.RevertErr)
+		}
+		return e.fail(reward, fmt.Errorf("payout transaction %s reverted", reward.TxHash.Hex()))
+	case utils.TX_REPLACED, utils.TX_DROPPED:
+		return e.fail(reward, fmt.Errorf("payout transaction %s was %v", reward.TxHash.Hex(), state.Status))
+	}
+	return nil
+}
+
+// transferItems sends the minted items from the signer to the player, then waits for the transfers
+func (e *Engine) transferItems(ctx context.Context, reward Reward) error {
+	minter, err := utils.SignerAddress(e.config, utils.ANIWAR_NFT, "createManyAniwarItem")
+	if err != nil {
+		return e.fail(reward, err)
+	}
+	sender, err := utils.SignerAddress(e.config, utils.ANIWAR_NFT, "transferFrom")
+	if err != nil {
+		return e.fail(reward, err)
+	}
+	if sender != minter {
+		return e.fail(reward, fmt.Errorf("AniwarNft.transferFrom must be sent by the minting signer %s", minter.Hex()))
+	}
+	for len(reward.TransferTxHashes) < len(reward.TokenIds) {
+		tokenId := reward.TokenIds[len(reward.TransferTxHashes)]
+		reward.Sending = true
+		if err := e.update(reward); err != nil {
+			return err
+		}
+		tx, err := utils.CallMethods(e.config, utils.ANIWAR_NFT, "transferFrom", big.NewInt(0), minter, reward.Player, tokenId)
+		reward.Sending = false
+		if err != nil {
+			return e.fail(reward, fmt.Errorf("transfer of item %v: %v", tokenId, err))
+		}
+		reward.TransferTxHashes = append(reward.TransferTxHashes, tx.Hash())
+		if err := e.update(reward); err != nil {
+			return err
+		}
+	}
+
+	for i, hash := range reward.TransferTxHashes {
+		state, err := e.config.Tracker.Status(ctx, hash)
+		if errors.Is(err, utils.ErrTxNotFound) {
+			return e.unknown(reward, hash)
+		}
+		if err != nil {
+			return err
+		}
+		switch state.Status {
+		case utils.TX_CONFIRMED:
+			continue
+		case utils.TX_REVERTED, utils.TX_REPLACED, utils.TX_DROPPED:
+			return e.fail(reward, fmt.Errorf("transfer of item %v: transaction %s was %v", reward.TokenIds[i], hash.Hex(), state.Status))
+		}
+		return nil
+	}
+	reward.Status = STATUS_PAID
+	return e.update(reward)
+}
+
+func (e *Engine) fail(reward Reward, err error) error {
+	reward.Status = STATUS_FAILED
+	reward.Error = err.Error()
+	return e.update(reward)
+}
+
+// unknown gives up on a transaction the node forgot after a restart, it may still be mined
+func (e *Engine) unknown(reward Reward, hash common.Hash) error {
+	reward.Status = STATUS_UNKNOWN
+	reward.Error = fmt.Sprintf("The node does not know transaction %s", hash.Hex())
+	return e.update(reward)
+}
+
+func (e *Engine) update(reward Reward) error {
+	reward.UpdatedAt = time.Now().Unix()
+	if err := e.ledger.Update(reward); err != nil {
+		return fmt.Errorf("Cannot store %s: %v", reward.Id, err)
+	}
+	return nil
+}
@@ -0,0 +1,159 @@
+package rewards
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/mineloop99/new-token/back_end/utils"
+	"github.com/mineloop99/new-token/back_end/utils/configtest"
+	"github.com/mineloop99/new-token/back_end/utils/utilstest"
+)
+
+var testRules = map[string]Rule{
+	"dailyLogin": {Name: "dailyLogin", Ani: big.NewInt(10), MaxQuantity: 7},
+	"bossDrop":   {Name: "bossDrop", Items: []string{"Sword", "Shield"}, MaxQuantity: 1},
+}
+
+func newTestEngine(t *testing.T) (*Engine, *utilstest.Chain) {
+	t.Helper()
+	chain := utilstest.NewChain(t)
+	token := configtest.Deploy(t, chain, utils.ANIWAR_TOKEN)
+	config := configtest.New(chain, token, configtest.Deploy(t, chain, utils.ANIWAR_NFT, token.Address))
+	return NewEngine(config, NewMemoryLedger(), testRules), chain
+}
+
+func getReward(t *testing.T, e *Engine, id string) Reward {
+	t.Helper()
+	reward, ok, err := e.Ledger().Get(id)
+	if err != nil || !ok {
+		t.Fatalf("%s: ok %v, err %v", id, ok, err)
+	}
+	return reward
+}
+
+func TestEnginePaysAniOnce(t *testing.T) {
+	e, sim := newTestEngine(t)
+	ctx := context.Background()
+	player := common.HexToAddress("0x0000000000000000000000000000000000000007")
+
+	reward, created, err := e.Grant("login-1", player, "dailyLogin", 3)
+	if err != nil || !created {
+		t.Fatalf("created %v, err %v", created, err)
+	}
+	if reward.Amount.Cmp(big.NewInt(30)) != 0 || reward.Status != STATUS_QUEUED {
+		t.Fatalf("reward = %+v", reward)
+	}
+	if _, created, err := e.Grant("login-1", player, "dailyLogin", 3); err != nil || created {
+		t.Fatalf("grant again: created %v, err %v", created, err)
+	}
+	if _, _, err := e.Grant("login-1", player, "dailyLogin", 4); !errors.Is(err, ErrRewardConflict) {
+		t.Fatalf("conflicting grant: err %v", err)
+	}
+	if _, _, err := e.Grant("login-2", player, "dailyLogin", 8); !errors.Is(err, ErrInvalidGrant) {
+		t.Fatalf("quantity over the rule: err %v", err)
+	}
+
+	if err := e.processQueue(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if reward := getReward(t, e, "login-1"); reward.Status != STATUS_SENT || reward.Sending {
+		t.Fatalf("after send: %+v", reward)
+	}
+	sim.Commit()
+	if err := e.processQueue(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if reward := getReward(t, e, "login-1"); reward.Status != STATUS_PAID {
+		t.Fatalf("after mining: %+v", reward)
+	}
+	// a paid reward is not sent again
+	if err := e.processQueue(ctx); err != nil {
+		t.Fatal(err)
+	}
+	sim.Commit()
+	result, err := utils.CallViewMethods(e.config, utils.ANIWAR_TOKEN, "balanceOf", big.NewInt(0), player)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if balance := result[0].(*big.Int); balance.Cmp(big.NewInt(30)) != 0 {
+		t.Errorf("player balance = %v, want 30", balance)
+	}
+}
+
+func TestEngineMarksInterruptedSendsUnknown(t *testing.T) {
+	e, _ := newTestEngine(t)
+	player := common.HexToAddress("0x0000000000000000000000000000000000000007")
+	reward, _, err := e.Grant("login-1", player, "dailyLogin", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// stopped between the Sending write and the send
+	reward.Sending = true
+	if err := e.Ledger().Update(reward); err != nil {
+		t.Fatal(err)
+	}
+	if err := e.recoverInterrupted(); err != nil {
+		t.Fatal(err)
+	}
+	if err := e.processQueue(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if reward := getReward(t, e, "login-1"); reward.Status != STATUS_UNKNOWN || reward.TxHash != (common.Hash{}) {
+		t.Fatalf("reward = %+v", reward)
+	}
+}
+
+func TestEnginePaysItems(t *testing.T) {
+	e, chain := newTestEngine(t)
+	ctx := context.Background()
+	player := common.HexToAddress("0x0000000000000000000000000000000000000007")
+	// the signer pays the mint fee of the 2 items, 200 ANI with 18 decimals each
+	token, nft := e.config.Contracts[utils.ANIWAR_TOKEN], e.config.Contracts[utils.ANIWAR_NFT]
+	fee := new(big.Int).Mul(big.NewInt(400), new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil))
+	chain.Transact(t, token.Address, token.ABI, "approve", nft.Address, fee)
+
+	if _, _, err := e.Grant("boss-1", player, "bossDrop", 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := e.processQueue(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if reward := getReward(t, e, "boss-1"); reward.Status != STATUS_SENT {
+		t.Fatalf("after the mint is sent: %+v", reward)
+	}
+	chain.Commit()
+	if err := e.processQueue(ctx); err != nil {
+		t.Fatal(err)
+	}
+	reward := getReward(t, e, "boss-1")
+	if reward.Status != STATUS_MINTED || len(reward.TokenIds) != 2 || reward.TokenIds[0].Int64() != 0 || reward.TokenIds[1].Int64() != 1 {
+		t.Fatalf("after the mint: %+v", reward)
+	}
+
+	// the transfers are sent, then found mined
+	if err := e.processQueue(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if reward := getReward(t, e, "boss-1"); reward.Status != STATUS_MINTED || len(reward.TransferTxHashes) != 2 {
+		t.Fatalf("after the transfers are sent: %+v", reward)
+	}
+	chain.Commit()
+	if err := e.processQueue(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if reward := getReward(t, e, "boss-1"); reward.Status != STATUS_PAID {
+		t.Fatalf("after the transfers: %+v", reward)
+	}
+	for _, tokenId := range reward.TokenIds {
+		result, err := utils.CallViewMethods(e.config, utils.ANIWAR_NFT, "ownerOf", big.NewInt(0), tokenId)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if owner := result[0].(common.Address); owner != player {
+			t.Errorf("item %v is owned by %s, want the player", tokenId, owner.Hex())
+		}
+	}
+}
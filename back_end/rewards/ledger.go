@@ -0,0 +1,293 @@
+package rewards
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/ethdb/leveldb"
+	"github.com/ethereum/go-ethereum/ethdb/memorydb"
+)
+
+var (
+	// rewardPrefix + reward id -> Reward JSON
+	rewardPrefix = []byte("r")
+	// orderPrefix + sequence (8 bytes) -> reward id, in grant order
+	orderPrefix = []byte("o")
+	// playerPrefix + player + sequence -> reward id, the rewards of a player in grant order
+	playerPrefix = []byte("p")
+	// statusPrefix + status + "/" + sequence -> reward id, the rewards in a status in grant order
+	statusPrefix    = []byte("s")
+	lastSequenceKey = []byte("sequence")
+	// Set once the player and status indexes hold every reward, older ledgers are indexed when opened
+	indexedKey = []byte("indexed")
+)
+
+type RewardStatus string
+
+const (
+	// Waiting for the payout queue
+	STATUS_QUEUED RewardStatus = "QUEUED"
+	// The payout transaction is sent
+	STATUS_SENT RewardStatus = "SENT"
+	// The items are minted to the signer, their transfers to the player are being sent
+	STATUS_MINTED RewardStatus = "MINTED"
+	STATUS_PAID   RewardStatus = "PAID"
+	STATUS_FAILED RewardStatus = "FAILED"
+	// The backend stopped while sending a transaction, check the signer account before paying it again
+	STATUS_UNKNOWN RewardStatus = "UNKNOWN"
+)
+
+// Final reports whether the payout queue is done with the reward
+func (s RewardStatus) Final() bool {
+	return s == STATUS_PAID || s == STATUS_FAILED || s == STATUS_UNKNOWN
+}
+
+// Reward is a ledger entry, one per reward id
+type Reward struct {
+	Id       string         `json:"id"`
+	Player   common.Address `json:"player"`
+	Rule     string         `json:"rule"`
+	Quantity uint64         `json:"quantity"`
+	Kind     PayoutKind     `json:"kind"`
+	Amount   *big.Int       `json:"amount,omitempty"`
+	Items    []string       `json:"items,omitempty"`
+	Status   RewardStatus   `json:"status"`
+	// createManyAniwarItem for items, transfer for ANI
+	TxHash   common.Hash `json:"txHash"`
+	TokenIds []*big.Int  `json:"tokenIds,omitempty"`
+	// transferFrom of each token id to the player
+	TransferTxHashes []common.Hash `json:"transferTxHashes,omitempty"`
+	// Set before a transaction is sent and cleared once its hash is stored, so a crash in between is not paid twice
+	Sending   bool   `json:"sending"`
+	Error     string `json:"error,omitempty"`
+	Sequence  uint64 `json:"sequence"`
+	CreatedAt int64  `json:"createdAt"`
+	UpdatedAt int64  `json:"updatedAt"`
+}
+
+// sameGrant reports whether other asks for the same payout
+func (r Reward) sameGrant(other Reward) bool {
+	return r.Player == other.Player && r.Rule == other.Rule && r.Quantity == other.Quantity
+}
+
+// Ledger persists the rewards in a key-value database
+type Ledger struct {
+	db ethdb.KeyValueStore
+	// Serializes the check and insert of a reward id
+	mu sync.Mutex
+}
+
+// OpenLedger opens or creates a LevelDB ledger in dir
+func OpenLedger(dir string) (*Ledger, error) {
+	db, err := leveldb.New(dir, 16, 16, "rewards/", false)
+	if err != nil {
+		return nil, fmt.Errorf("Rewards: Cannot open ledger %s: %v", dir, err)
+	}
+	l := &Ledger{db: db}
+	if err := l.index(); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("Rewards: Cannot index ledger %s: %v", dir, err)
+	}
+	return l, nil
+}
+
+// NewMemoryLedger returns a ledger that is lost on exit, for tests
+func NewMemoryLedger() *Ledger {
+	return &Ledger{db: memorydb.New()}
+}
+
+// index adds the rewards of a ledger written before the player and status indexes to them
+func (l *Ledger) index() error {
+	indexed, err := l.db.Has(indexedKey)
+	if err != nil || indexed {
+		return err
+	}
+	batch := l.db.NewBatch()
+	var putErr error
+	err = l.Rewards(0, func(reward Reward) bool {
+		putErr = putIndexes(batch, reward)
+		return putErr == nil
+	})
+	if err != nil {
+		return err
+	}
+	if putErr != nil {
+		return putErr
+	}
+	if err := batch.Put(indexedKey, []byte{1}); err != nil {
+		return err
+	}
+	return batch.Write()
+}
+
+func (l *Ledger) Close() error {
+	return l.db.Close()
+}
+
+func rewardKey(id string) []byte {
+	return append(append([]byte{}, rewardPrefix...), id...)
+}
+
+func orderKey(sequence uint64) []byte {
+	return sequenceKey(orderPrefix, sequence)
+}
+
+func playerKey(player common.Address, sequence uint64) []byte {
+	return sequenceKey(playerIndex(player), sequence)
+}
+
+func playerIndex(player common.Address) []byte {
+	return append(append([]byte{}, playerPrefix...), player.Bytes()...)
+}
+
+func statusKey(status RewardStatus, sequence uint64) []byte {
+	return sequenceKey(statusIndex(status), sequence)
+}
+
+func statusIndex(status RewardStatus) []byte {
+	return append(append(append([]byte{}, statusPrefix...), status...), '/')
+}
+
+// sequenceKey appends the big-endian sequence to prefix, so the keys of a prefix are in grant order
+func sequenceKey(prefix []byte, sequence uint64) []byte {
+	key := make([]byte, len(prefix)+8)
+	copy(key, prefix)
+	binary.BigEndian.PutUint64(key[len(prefix):], sequence)
+	return key
+}
+
+// putIndexes adds reward to the player and status indexes
+func putIndexes(batch ethdb.Batch, reward Reward) error {
+	if err := batch.Put(playerKey(reward.Player, reward.Sequence), []byte(reward.Id)); err != nil {
+		return err
+	}
+	return batch.Put(statusKey(reward.Status, reward.Sequence), []byte(reward.Id))
+}
+
+// Get returns the reward with id, ok is false when it was never granted
+func (l *Ledger) Get(id string) (reward Reward, ok bool, err error) {
+	// ethdb reports missing keys as errors
+	has, err := l.db.Has(rewardKey(id))
+	if err != nil || !has {
+		return Reward{}, false, err
+	}
+	data, err := l.db.Get(rewardKey(id))
+	if err != nil {
+		return Reward{}, false, err
+	}
+	err = json.Unmarshal(data, &reward)
+	return reward, err == nil, err
+}
+
+// Create stores a new reward after the last one. When the id exists the stored reward is returned
+// with created false and nothing is written
+func (l *Ledger) Create(reward Reward) (stored Reward, created bool, err error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	existing, ok, err := l.Get(reward.Id)
+	if err != nil || ok {
+		return existing, false, err
+	}
+	sequence := uint64(0)
+	has, err := l.db.Has(lastSequenceKey)
+	if err != nil {
+		return Reward{}, false, err
+	}
+	if has {
+		data, err := l.db.Get(lastSequenceKey)
+		if err != nil {
+			return Reward{}, false, err
+		}
+		sequence = binary.BigEndian.Uint64(data) + 1
+	}
+	reward.Sequence = sequence
+
+	batch := l.db.NewBatch()
+	value, err := json.Marshal(reward)
+	if err != nil {
+		return Reward{}, false, err
+	}
+	if err := batch.Put(rewardKey(reward.Id), value); err != nil {
+		return Reward{}, false, err
+	}
+	if err := batch.Put(orderKey(sequence), []byte(reward.Id)); err != nil {
+		return Reward{}, false, err
+	}
+	if err := putIndexes(batch, reward); err != nil {
+		return Reward{}, false, err
+	}
+	if err := batch.Put(lastSequenceKey, orderKey(sequence)[len(orderPrefix):]); err != nil {
+		return Reward{}, false, err
+	}
+	return reward, true, batch.Write()
+}
+
+// Update stores the new state of an existing reward and moves it to the index of its new status
+func (l *Ledger) Update(reward Reward) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	stored, ok, err := l.Get(reward.Id)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return fmt.Errorf("Rewards: %q is not granted", reward.Id)
+	}
+	value, err := json.Marshal(reward)
+	if err != nil {
+		return err
+	}
+	batch := l.db.NewBatch()
+	if err := batch.Put(rewardKey(reward.Id), value); err != nil {
+		return err
+	}
+	if stored.Status != reward.Status {
+		if err := batch.Delete(statusKey(stored.Status, stored.Sequence)); err != nil {
+			return err
+		}
+		if err := batch.Put(statusKey(reward.Status, reward.Sequence), []byte(reward.Id)); err != nil {
+			return err
+		}
+	}
+	return batch.Write()
+}
+
+// Rewards calls fn with the rewards from sequence on, in grant order, until fn returns false
+func (l *Ledger) Rewards(sequence uint64, fn func(Reward) bool) error {
+	return l.iterate(orderPrefix, sequence, fn)
+}
+
+// RewardsOf calls fn with the rewards of player from sequence on, in grant order, until fn returns false
+func (l *Ledger) RewardsOf(player common.Address, sequence uint64, fn func(Reward) bool) error {
+	return l.iterate(playerIndex(player), sequence, fn)
+}
+
+// RewardsWithStatus calls fn with the rewards in status from sequence on, in grant order, until fn
+// returns false
+func (l *Ledger) RewardsWithStatus(status RewardStatus, sequence uint64, fn func(Reward) bool) error {
+	return l.iterate(statusIndex(status), sequence, fn)
+}
+
+// iterate calls fn with the rewards whose id is stored under prefix + sequence, from sequence on
+func (l *Ledger) iterate(prefix []byte, sequence uint64, fn func(Reward) bool) error {
+	it := l.db.NewIterator(prefix, sequenceKey(prefix, sequence)[len(prefix):])
+	defer it.Release()
+	for it.Next() {
+		reward, ok, err := l.Get(string(it.Value()))
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return fmt.Errorf("Rewards: Corrupted ledger, %q is ordered but missing", it.Value())
+		}
+		if !fn(reward) {
+			break
+		}
+	}
+	return it.Error()
+}
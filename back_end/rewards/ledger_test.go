@@ -0,0 +1,98 @@
+package rewards
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ids calls list and returns the ids it pages, in order
+func ids(t *testing.T, list func(fn func(Reward) bool) error) []string {
+	t.Helper()
+	var found []string
+	err := list(func(reward Reward) bool {
+		found = append(found, reward.Id)
+		return true
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return found
+}
+
+func sameIds(a []string, b ...string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestLedgerIndexesPlayersAndStatuses(t *testing.T) {
+	l := NewMemoryLedger()
+	alice := common.HexToAddress("0x0000000000000000000000000000000000000007")
+	bob := common.HexToAddress("0x0000000000000000000000000000000000000008")
+	for _, reward := range []Reward{
+		{Id: "a-1", Player: alice, Status: STATUS_QUEUED},
+		{Id: "b-1", Player: bob, Status: STATUS_QUEUED},
+		{Id: "a-2", Player: alice, Status: STATUS_QUEUED},
+	} {
+		if _, _, err := l.Create(reward); err != nil {
+			t.Fatal(err)
+		}
+	}
+	paid, _, err := l.Get("a-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	paid.Status = STATUS_PAID
+	if err := l.Update(paid); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := ids(t, func(fn func(Reward) bool) error { return l.RewardsOf(alice, 0, fn) }); !sameIds(got, "a-1", "a-2") {
+		t.Errorf("rewards of alice = %v", got)
+	}
+	// from the sequence of a-2
+	if got := ids(t, func(fn func(Reward) bool) error { return l.RewardsOf(alice, 2, fn) }); !sameIds(got, "a-2") {
+		t.Errorf("rewards of alice from 2 = %v", got)
+	}
+	if got := ids(t, func(fn func(Reward) bool) error { return l.RewardsWithStatus(STATUS_QUEUED, 0, fn) }); !sameIds(got, "b-1", "a-2") {
+		t.Errorf("queued rewards = %v", got)
+	}
+	if got := ids(t, func(fn func(Reward) bool) error { return l.RewardsWithStatus(STATUS_PAID, 0, fn) }); !sameIds(got, "a-1") {
+		t.Errorf("paid rewards = %v", got)
+	}
+	if err := l.Update(Reward{Id: "c-1", Status: STATUS_PAID}); err == nil {
+		t.Error("a reward that was never granted is updated")
+	}
+}
+
+func TestLedgerIndexesOlderLedgers(t *testing.T) {
+	l := NewMemoryLedger()
+	player := common.HexToAddress("0x0000000000000000000000000000000000000007")
+	for _, id := range []string{"login-1", "login-2"} {
+		if _, _, err := l.Create(Reward{Id: id, Player: player, Status: STATUS_SENT}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// written before the indexes
+	for _, key := range [][]byte{playerKey(player, 0), playerKey(player, 1), statusKey(STATUS_SENT, 0), statusKey(STATUS_SENT, 1)} {
+		if err := l.db.Delete(key); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := l.index(); err != nil {
+		t.Fatal(err)
+	}
+	if got := ids(t, func(fn func(Reward) bool) error { return l.RewardsOf(player, 0, fn) }); !sameIds(got, "login-1", "login-2") {
+		t.Errorf("rewards of the player = %v", got)
+	}
+	if got := ids(t, func(fn func(Reward) bool) error { return l.RewardsWithStatus(STATUS_SENT, 0, fn) }); !sameIds(got, "login-1", "login-2") {
+		t.Errorf("sent rewards = %v", got)
+	}
+}
@@ -0,0 +1,115 @@
+package rewards
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/spf13/viper"
+)
+
+// createManyAniwarItem mints at most 10 items per call
+const MAX_REWARD_ITEMS = 10
+
+type PayoutKind string
+
+const (
+	// ANI sent with AniwarToken.transfer
+	PAYOUT_ANI PayoutKind = "ANI"
+	// Items minted with AniwarNft.createManyAniwarItem, then sent with transferFrom
+	PAYOUT_NFT PayoutKind = "NFT"
+)
+
+// Rule is a reward players can be granted. A grant pays the rule quantity times: Ani ANI, or the Items
+type Rule struct {
+	Name string
+	// ANI in its smallest unit, per quantity
+	Ani *big.Int
+	// Item names minted per quantity
+	Items []string
+	// Largest quantity of a grant, 1 when not set
+	MaxQuantity uint64
+}
+
+type ruleConfig struct {
+	Name        string   `mapstructure:"name"`
+	Ani         string   `mapstructure:"ani"`
+	Items       []string `mapstructure:"items"`
+	MaxQuantity uint64   `mapstructure:"maxQuantity"`
+}
+
+// Entitlement is what a grant pays
+type Entitlement struct {
+	Kind   PayoutKind
+	Amount *big.Int
+	Items  []string
+}
+
+func (r Rule) Kind() PayoutKind {
+	if len(r.Items) > 0 {
+		return PAYOUT_NFT
+	}
+	return PAYOUT_ANI
+}
+
+// Entitlement computes the payout of a grant of quantity, 0 counting as 1
+func (r Rule) Entitlement(quantity uint64) (Entitlement, error) {
+	if quantity == 0 {
+		quantity = 1
+	}
+	if quantity > r.MaxQuantity {
+		return Entitlement{}, fmt.Errorf("quantity %d is over the %d of rule %s", quantity, r.MaxQuantity, r.Name)
+	}
+	if r.Kind() == PAYOUT_ANI {
+		return Entitlement{Kind: PAYOUT_ANI, Amount: new(big.Int).Mul(r.Ani, new(big.Int).SetUint64(quantity))}, nil
+	}
+	if uint64(len(r.Items))*quantity > MAX_REWARD_ITEMS {
+		return Entitlement{}, fmt.Errorf("rule %s pays %d items, at most %d can be minted at once", r.Name, uint64(len(r.Items))*quantity, MAX_REWARD_ITEMS)
+	}
+	var items []string
+	for i := uint64(0); i < quantity; i++ {
+		items = append(items, r.Items...)
+	}
+	return Entitlement{Kind: PAYOUT_NFT, Items: items}, nil
+}
+
+// loadRules reads rewards.rules. A rule pays either ANI or items
+func loadRules() (map[string]Rule, error) {
+	var ruleConfigs []ruleConfig
+	err := viper.UnmarshalKey("rewards.rules", &ruleConfigs)
+	if err != nil {
+		return nil, fmt.Errorf("Config: Cannot read rewards.rules: %v", err)
+	}
+	rules := make(map[string]Rule)
+	for _, ruleConfig := range ruleConfigs {
+		rule := Rule{Name: ruleConfig.Name, Items: ruleConfig.Items, MaxQuantity: ruleConfig.MaxQuantity}
+		if rule.Name == "" {
+			return nil, fmt.Errorf("Config: rewards.rules: A rule has no name")
+		}
+		if _, ok := rules[rule.Name]; ok {
+			return nil, fmt.Errorf("Config: rewards.rules: %s is defined twice", rule.Name)
+		}
+		if rule.MaxQuantity == 0 {
+			rule.MaxQuantity = 1
+		}
+		if (ruleConfig.Ani == "") == (len(rule.Items) == 0) {
+			return nil, fmt.Errorf("Config: rewards.rules: %s must pay either ani or items", rule.Name)
+		}
+		if ruleConfig.Ani != "" {
+			amount, ok := new(big.Int).SetString(ruleConfig.Ani, 10)
+			if !ok || amount.Sign() <= 0 {
+				return nil, fmt.Errorf("Config: rewards.rules: %s: Invalid ani %q", rule.Name, ruleConfig.Ani)
+			}
+			rule.Ani = amount
+		}
+		for _, item := range rule.Items {
+			if item == "" {
+				return nil, fmt.Errorf("Config: rewards.rules: %s: An item name is empty", rule.Name)
+			}
+		}
+		if len(rule.Items) > MAX_REWARD_ITEMS {
+			return nil, fmt.Errorf("Config: rewards.rules: %s: At most %d items", rule.Name, MAX_REWARD_ITEMS)
+		}
+		rules[rule.Name] = rule
+	}
+	return rules, nil
+}
@@ -5,9 +5,16 @@ import (
 	"net"
 	"time"
 
+	"github.com/mineloop99/new-token/back_end/features/events"
+	"github.com/mineloop99/new-token/back_end/features/farm"
 	"github.com/mineloop99/new-token/back_end/features/nft"
+	"github.com/mineloop99/new-token/back_end/features/pool"
 	"github.com/mineloop99/new-token/back_end/features/reward"
+	"github.com/mineloop99/new-token/back_end/features/shop"
 	"github.com/mineloop99/new-token/back_end/features/token"
+	"github.com/mineloop99/new-token/back_end/features/tokensale"
+	"github.com/mineloop99/new-token/back_end/features/transaction"
+	"github.com/mineloop99/new-token/back_end/features/vesting"
 
 	"google.golang.org/grpc"
 )
@@ -29,6 +36,13 @@ func registerServer(host string, port string) (*grpc.Server, net.Listener) {
 	reward.RewardRegister(s)
 	nft.RewardRegister(s)
 	token.RewardRegister(s)
+	transaction.RewardRegister(s)
+	farm.RewardRegister(s)
+	pool.RewardRegister(s)
+	vesting.RewardRegister(s)
+	tokensale.RewardRegister(s)
+	shop.RewardRegister(s)
+	events.RewardRegister(s)
 
 	return s, lis
 }
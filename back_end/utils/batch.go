@@ -0,0 +1,135 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// Max eth_call per JSON-RPC batch, public nodes reject bigger batches
+const BATCH_SIZE = 100
+
+// ViewCall is one eth_call of a batch. Result and Err are filled by BatchCallViewMethods
+type ViewCall struct {
+	ContractName string
+	MethodName   string
+	Args         []interface{}
+	Result       []interface{}
+	Err          error
+}
+
+// BatchCallViewMethods sends calls in chunks of BATCH_SIZE: as one Multicall3 aggregate3 eth_call
+// when config.Multicall is set, else as JSON-RPC batches. The returned error is only set
+// when a whole chunk could not be sent, failures of single calls are reported in their Err field.
+// Over Multicall msg.sender is the Multicall contract, not AccountAddress
+func BatchCallViewMethods(config Config, calls []*ViewCall) error {
+	for start := 0; start < len(calls); start += BATCH_SIZE {
+		end := start + BATCH_SIZE
+		if end > len(calls) {
+			end = len(calls)
+		}
+		var err error
+		switch {
+		case config.Multicall != (common.Address{}):
+			err = multicallViewMethods(config, calls[start:end])
+		case config.RpcClient != nil:
+			err = batchCallViewMethods(config, calls[start:end])
+		default:
+			// Backends without JSON-RPC, such as the simulated one of the tests
+			for _, call := range calls[start:end] {
+				call.Result, call.Err = CallViewMethods(config, call.ContractName, call.MethodName, nil, call.Args...)
+			}
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// packedCall is a ViewCall ready to send
+type packedCall struct {
+	call     *ViewCall
+	contract Contract
+	data     []byte
+}
+
+// packCalls packs the calls, the ones that cannot be packed get their Err set and are left out
+func packCalls(config Config, calls []*ViewCall) []packedCall {
+	var packed []packedCall
+	for _, call := range calls {
+		call.Result, call.Err = nil, nil
+		contract, err := config.GetContract(call.ContractName)
+		if err != nil {
+			call.Err = err
+			continue
+		}
+		data, err := packCall(contract, call.MethodName, call.Args...)
+		if err != nil {
+			call.Err = err
+			continue
+		}
+		packed = append(packed, packedCall{call: call, contract: contract, data: data})
+	}
+	return packed
+}
+
+// unpack sets the decoded result of a successful call
+func (p packedCall) unpack(output []byte) {
+	result, err := p.contract.ABI.Unpack(p.call.MethodName, output)
+	if err != nil {
+		p.call.Err = &UnpackError{Method: p.call.MethodName, Err: err}
+		return
+	}
+	p.call.Result = result
+}
+
+func batchCallViewMethods(config Config, calls []*ViewCall) error {
+	packed := packCalls(config, calls)
+	if len(packed) == 0 {
+		return nil
+	}
+	elems := make([]rpc.BatchElem, len(packed))
+	results := make([]hexutil.Bytes, len(packed))
+	for i, p := range packed {
+		arg := map[string]interface{}{
+			"from": common.HexToAddress(config.AccountAddress),
+			"to":   p.contract.Address,
+			"data": hexutil.Bytes(p.data),
+		}
+		elems[i] = rpc.BatchElem{Method: "eth_call", Args: []interface{}{arg, config.blockTag()}, Result: &results[i]}
+	}
+
+	d := time.Now().Add(CALL_TIMEOUT)
+	ctx, cancel := context.WithDeadline(context.Background(), d)
+	defer cancel()
+	err := config.RpcClient.BatchCallContext(ctx, elems)
+	if err != nil {
+		return &RpcError{Method: "eth_call batch", Err: err}
+	}
+
+	for i, p := range packed {
+		if elems[i].Error != nil {
+			p.call.Err = rpcErrorFor(p.call.MethodName, elems[i].Error)
+			continue
+		}
+		p.unpack(results[i])
+	}
+	return nil
+}
+
+// packCall packs the call data, reporting methods missing from the loaded artifact separately from bad arguments
+func packCall(contract Contract, methodName string, args ...interface{}) ([]byte, error) {
+	if _, ok := contract.ABI.Methods[methodName]; !ok {
+		return nil, fmt.Errorf("%s.%s: %w", contract.Name, methodName, ErrMethodNotFound)
+	}
+	data, err := contract.ABI.Pack(methodName, args...)
+	if err != nil {
+		return nil, &PackError{Method: methodName, Err: err}
+	}
+	return data, nil
+}
@@ -0,0 +1,190 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/mineloop99/new-token/back_end/features/block/block_pb"
+)
+
+var (
+	ErrInvalidBlock  = errors.New("invalid block selector")
+	ErrBlockNotFound = errors.New("block not found")
+	// Full nodes keep the state of the last blocks only, older reads need an archive node
+	ErrStateUnavailable = errors.New("the node has no state for this block, an archive node is required")
+)
+
+// EIP-1474 "Resource unavailable", the code Nethermind and Besu answer reads of pruned state with
+const RPC_RESOURCE_UNAVAILABLE = -32002
+
+// Node errors for reads of pruned state: geth, BSC and Erigon answer them with the generic -32000 code
+var missingStateMessages = []string{
+	"missing trie node",
+	"historical state",
+	"state is not available",
+	"state not available",
+	"state histories",
+	"pruned",
+}
+
+func isMissingState(err error) bool {
+	var rpcErr rpc.Error
+	if errors.As(err, &rpcErr) && rpcErr.ErrorCode() == RPC_RESOURCE_UNAVAILABLE {
+		return true
+	}
+	message := err.Error()
+	for _, missing := range missingStateMessages {
+		if strings.Contains(message, missing) {
+			return true
+		}
+	}
+	return false
+}
+
+// BlockSelector picks the block of a historical read, the latest one when empty. At most one field is set
+type BlockSelector struct {
+	Number *big.Int
+	// 0x hex
+	Hash string
+	// Unix seconds, the last block mined at or before it
+	Timestamp uint64
+}
+
+// AtBlock returns a copy of config whose view calls read the state at block, the latest one when nil
+func (config Config) AtBlock(block *big.Int) Config {
+	config.Block = block
+	return config
+}
+
+// AtBlock returns a copy of config reading the state at the block at selects, see ResolveBlock.
+// A nil at reads the latest block
+func AtBlock(ctx context.Context, config Config, at *block_pb.AtBlock) (Config, error) {
+	var selector BlockSelector
+	switch block := at.GetBlock().(type) {
+	case *block_pb.AtBlock_Number:
+		selector.Number = new(big.Int).SetUint64(block.Number)
+	case *block_pb.AtBlock_Hash:
+		selector.Hash = block.Hash
+	case *block_pb.AtBlock_Timestamp:
+		selector.Timestamp = block.Timestamp
+	}
+	number, err := ResolveBlock(ctx, config, selector)
+	if err != nil {
+		return config, err
+	}
+	return config.AtBlock(number), nil
+}
+
+// Header returns the header of config.Block, the latest one when nil
+func (config Config) Header(ctx context.Context) (*types.Header, error) {
+	header, err := config.Client.HeaderByNumber(ctx, config.Block)
+	if err != nil {
+		return nil, &RpcError{Method: "eth_getBlockByNumber", Err: err}
+	}
+	return header, nil
+}
+
+// blockTag is the JSON-RPC block parameter of config.Block
+func (config Config) blockTag() string {
+	if config.Block == nil {
+		return "latest"
+	}
+	return hexutil.EncodeBig(config.Block)
+}
+
+// ResolveBlock returns the number of the selected block, nil for the latest one. The block must be in
+// the canonical chain and not past the head
+func ResolveBlock(ctx context.Context, config Config, selector BlockSelector) (*big.Int, error) {
+	set := 0
+	for _, isSet := range []bool{selector.Number != nil, selector.Hash != "", selector.Timestamp != 0} {
+		if isSet {
+			set++
+		}
+	}
+	if set > 1 {
+		return nil, fmt.Errorf("%w: set one of block number, hash or timestamp", ErrInvalidBlock)
+	}
+	switch {
+	case selector.Number != nil:
+		head, err := config.Client.HeaderByNumber(ctx, nil)
+		if err != nil {
+			return nil, &RpcError{Method: "eth_getBlockByNumber", Err: err}
+		}
+		if selector.Number.Sign() < 0 || selector.Number.Cmp(head.Number) > 0 {
+			return nil, fmt.Errorf("block %v is past the head %v: %w", selector.Number, head.Number, ErrBlockNotFound)
+		}
+		return selector.Number, nil
+	case selector.Hash != "":
+		data, err := hexutil.Decode(selector.Hash)
+		if err != nil || len(data) != common.HashLength {
+			return nil, fmt.Errorf("%w: block hash %q", ErrInvalidBlock, selector.Hash)
+		}
+		hash := common.BytesToHash(data)
+		header, err := config.Client.HeaderByHash(ctx, hash)
+		if errors.Is(err, ethereum.NotFound) {
+			return nil, fmt.Errorf("block %s: %w", hash.Hex(), ErrBlockNotFound)
+		}
+		if err != nil {
+			return nil, &RpcError{Method: "eth_getBlockByHash", Err: err}
+		}
+		canonical, err := config.Client.HeaderByNumber(ctx, header.Number)
+		if err != nil {
+			return nil, &RpcError{Method: "eth_getBlockByNumber", Err: err}
+		}
+		if canonical.Hash() != hash {
+			return nil, fmt.Errorf("block %s was replaced by a reorg: %w", hash.Hex(), ErrBlockNotFound)
+		}
+		return header.Number, nil
+	case selector.Timestamp != 0:
+		return BlockAtTimestamp(ctx, config, selector.Timestamp)
+	}
+	return nil, nil
+}
+
+// BlockAtTimestamp returns the last block mined at or before timestamp, by binary search on the headers
+func BlockAtTimestamp(ctx context.Context, config Config, timestamp uint64) (*big.Int, error) {
+	header := func(number uint64) (*types.Header, error) {
+		header, err := config.Client.HeaderByNumber(ctx, new(big.Int).SetUint64(number))
+		if err != nil {
+			return nil, &RpcError{Method: "eth_getBlockByNumber", Err: err}
+		}
+		return header, nil
+	}
+	head, err := config.Client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, &RpcError{Method: "eth_getBlockByNumber", Err: err}
+	}
+	if head.Time <= timestamp {
+		return head.Number, nil
+	}
+	genesis, err := header(0)
+	if err != nil {
+		return nil, err
+	}
+	if genesis.Time > timestamp {
+		return nil, fmt.Errorf("timestamp %d is before the genesis block: %w", timestamp, ErrBlockNotFound)
+	}
+	// low is mined at or before timestamp, high after it
+	low, high := uint64(0), head.Number.Uint64()
+	for high-low > 1 {
+		middle := low + (high-low)/2
+		h, err := header(middle)
+		if err != nil {
+			return nil, err
+		}
+		if h.Time <= timestamp {
+			low = middle
+		} else {
+			high = middle
+		}
+	}
+	return new(big.Int).SetUint64(low), nil
+}
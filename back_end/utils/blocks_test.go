@@ -0,0 +1,164 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/mineloop99/new-token/back_end/features/block/block_pb"
+)
+
+func TestBlockAtTimestamp(t *testing.T) {
+	config, backend := newTestConfig(t)
+	ctx := context.Background()
+	for i := 0; i < 20; i++ {
+		backend.Commit()
+	}
+	head, err := backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	genesis, err := backend.HeaderByNumber(ctx, big.NewInt(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for number := uint64(0); number <= head.Number.Uint64(); number++ {
+		header, err := backend.HeaderByNumber(ctx, new(big.Int).SetUint64(number))
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := BlockAtTimestamp(ctx, config, header.Time)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got.Uint64() != number {
+			t.Fatalf("block at the time of block %d: got %v", number, got)
+		}
+		if number == 0 {
+			continue
+		}
+		// Between two blocks the earlier one was the head
+		got, err = BlockAtTimestamp(ctx, config, header.Time-1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got.Uint64() != number-1 {
+			t.Fatalf("block just before block %d: got %v", number, got)
+		}
+	}
+
+	got, err := BlockAtTimestamp(ctx, config, head.Time+1000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Cmp(head.Number) != 0 {
+		t.Fatalf("block after the head: got %v, want %v", got, head.Number)
+	}
+	// The simulated genesis is at time 0, which no selector can be before
+	if genesis.Time > 0 {
+		if _, err := BlockAtTimestamp(ctx, config, genesis.Time-1); !errors.Is(err, ErrBlockNotFound) {
+			t.Fatalf("block before the genesis: got %v, want ErrBlockNotFound", err)
+		}
+	}
+}
+
+func TestResolveBlock(t *testing.T) {
+	config, backend := newTestConfig(t)
+	ctx := context.Background()
+	for i := 0; i < 3; i++ {
+		backend.Commit()
+	}
+	header, err := backend.HeaderByNumber(ctx, big.NewInt(2))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	number, err := ResolveBlock(ctx, config, BlockSelector{})
+	if err != nil || number != nil {
+		t.Fatalf("empty selector: got %v, %v, want the latest block", number, err)
+	}
+	number, err = ResolveBlock(ctx, config, BlockSelector{Hash: header.Hash().Hex()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if number.Uint64() != 2 {
+		t.Fatalf("block by hash: got %v, want 2", number)
+	}
+
+	for _, test := range []struct {
+		selector BlockSelector
+		want     error
+	}{
+		{BlockSelector{Number: big.NewInt(1), Timestamp: header.Time}, ErrInvalidBlock},
+		{BlockSelector{Hash: "0x1234"}, ErrInvalidBlock},
+		{BlockSelector{Number: big.NewInt(100)}, ErrBlockNotFound},
+	} {
+		if _, err := ResolveBlock(ctx, config, test.selector); !errors.Is(err, test.want) {
+			t.Fatalf("%+v: got %v, want %v", test.selector, err, test.want)
+		}
+	}
+}
+
+func TestAtBlock(t *testing.T) {
+	config, backend := newTestConfig(t)
+	ctx := context.Background()
+	for i := 0; i < 3; i++ {
+		backend.Commit()
+	}
+	header, err := backend.HeaderByNumber(ctx, big.NewInt(2))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, test := range []struct {
+		at   *block_pb.AtBlock
+		want *big.Int
+	}{
+		{nil, nil},
+		{&block_pb.AtBlock{}, nil},
+		// Block 0 is the genesis, not the latest block
+		{&block_pb.AtBlock{Block: &block_pb.AtBlock_Number{Number: 0}}, big.NewInt(0)},
+		{&block_pb.AtBlock{Block: &block_pb.AtBlock_Number{Number: 1}}, big.NewInt(1)},
+		{&block_pb.AtBlock{Block: &block_pb.AtBlock_Hash{Hash: header.Hash().Hex()}}, big.NewInt(2)},
+		{&block_pb.AtBlock{Block: &block_pb.AtBlock_Timestamp{Timestamp: header.Time}}, big.NewInt(2)},
+	} {
+		got, err := AtBlock(ctx, config, test.at)
+		if err != nil {
+			t.Fatalf("%v: %v", test.at, err)
+		}
+		if (got.Block == nil) != (test.want == nil) || (test.want != nil && got.Block.Cmp(test.want) != 0) {
+			t.Fatalf("%v: got block %v, want %v", test.at, got.Block, test.want)
+		}
+	}
+	if _, err := AtBlock(ctx, config, &block_pb.AtBlock{Block: &block_pb.AtBlock_Number{Number: 100}}); !errors.Is(err, ErrBlockNotFound) {
+		t.Fatalf("block past the head: got %v, want ErrBlockNotFound", err)
+	}
+}
+
+// codeError is a JSON-RPC error answered by the node
+type codeError struct {
+	code    int
+	message string
+}
+
+func (e codeError) Error() string  { return e.message }
+func (e codeError) ErrorCode() int { return e.code }
+
+func TestMissingStateError(t *testing.T) {
+	for _, test := range []struct {
+		err     error
+		missing bool
+	}{
+		{codeError{RPC_RESOURCE_UNAVAILABLE, "requested resource not available"}, true},
+		{codeError{-32000, "missing trie node 1234 (path )"}, true},
+		{errors.New("missing trie node 1234 (path )"), true},
+		{codeError{-32000, "header not found"}, false},
+	} {
+		err := rpcErrorFor("eth_call", test.err)
+		if errors.Is(err, ErrStateUnavailable) != test.missing {
+			t.Errorf("%v: got %v, want missing state %v", test.err, err, test.missing)
+		}
+	}
+}
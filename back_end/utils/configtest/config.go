@@ -0,0 +1,42 @@
+// Package configtest builds the utils.Config of the service tests over a utilstest chain
+package configtest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mineloop99/new-token/back_end/utils"
+	"github.com/mineloop99/new-token/back_end/utils/utilstest"
+)
+
+const POLL_INTERVAL = time.Millisecond * 10
+
+// Deploy deploys a chain-info contract on chain
+func Deploy(t *testing.T, chain *utilstest.Chain, name string, args ...interface{}) utils.Contract {
+	t.Helper()
+	address, contractABI := chain.Deploy(t, name, args...)
+	return utils.Contract{Name: name, Address: address, ABI: contractABI}
+}
+
+// New returns a config sending every method from the chain deployer to contracts
+func New(chain *utilstest.Chain, contracts ...utils.Contract) utils.Config {
+	config := utils.Config{
+		AccountAddress: chain.Deployer.Hex(),
+		Signer:         utils.NewKeySigner(chain.Key),
+		ChainId:        utilstest.ChainId(),
+		Client:         chain.SimulatedBackend,
+		Contracts:      make(map[string]utils.Contract),
+	}
+	for _, contract := range contracts {
+		config.Contracts[contract.Name] = contract
+	}
+	config.Nonces = utils.NewNonceManager(chain.SimulatedBackend)
+	config.Tracker = utils.NewTxTracker(config.Client, config.Nonces, 1, POLL_INTERVAL, 0)
+	return config
+}
+
+// Use makes config the one utils.GetConfig returns until the test ends
+func Use(t *testing.T, config utils.Config) {
+	utils.SetConfig(config)
+	t.Cleanup(func() { utils.SetConfig(utils.Config{}) })
+}
@@ -0,0 +1,104 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/viper"
+)
+
+// Contract names as they appear in chain-info/deployments/map.json
+const (
+	ANIWAR_TOKEN      = "AniwarToken"
+	ANIWAR_NFT        = "AniwarNft"
+	ANIWAR_FARM       = "AniwarFarm"
+	ANIWAR_POOL       = "AniwarPool"
+	ANIWAR_VESTING    = "AniwarVesting"
+	ANIWAR_VESTING_V2 = "AniwarVestingV2"
+	ANIWAR_TOKEN_SALE = "AniwarTokenSale"
+	SPEND_ANI         = "SpendAni"
+)
+
+type Contract struct {
+	Name    string
+	Address common.Address
+	ABI     abi.ABI
+	// Deployment block, event queries start there. From chains.<chainId>.startBlocks.<name>, 0 when unset
+	StartBlock uint64
+}
+
+// GetContract returns the deployed contract registered under name
+func (config Config) GetContract(name string) (Contract, error) {
+	contract, ok := config.Contracts[name]
+	if !ok {
+		return Contract{}, fmt.Errorf("%s: %w", name, ErrNotDeployed)
+	}
+	return contract, nil
+}
+
+// loadContracts loads every contract listed in map.json for chainId together with its ABI
+func loadContracts(providePath string, chainId string) (map[string]Contract, error) {
+	mapBytes, err := readFile(providePath + "chain-info/deployments/map.json")
+	if err != nil {
+		return nil, fmt.Errorf("Config: Cannot Read map.json: %v", err)
+	}
+	var mapResult map[string]map[string][]string
+	err = json.Unmarshal(mapBytes, &mapResult)
+	if err != nil {
+		return nil, fmt.Errorf("Config: Cannot Unmarshal map.json: %v", err)
+	}
+
+	deployments, ok := mapResult[chainId]
+	if !ok {
+		return nil, fmt.Errorf("Config: map.json has no deployments for chain id %s", chainId)
+	}
+	contracts := make(map[string]Contract)
+	for name, addresses := range deployments {
+		if len(addresses) == 0 {
+			continue
+		}
+		// brownie prepends new deployments, the first address is the latest one
+		if !common.IsHexAddress(addresses[0]) {
+			return nil, fmt.Errorf("Config: Invalid address %s for %s", addresses[0], name)
+		}
+		contractABI, err := loadABI(providePath + "chain-info/contracts/" + name + ".json")
+		if err != nil {
+			return nil, fmt.Errorf("Config: Cannot load ABI of %s: %v", name, err)
+		}
+		contracts[name] = Contract{
+			Name:       name,
+			Address:    common.HexToAddress(addresses[0]),
+			ABI:        contractABI,
+			StartBlock: viper.GetUint64("chains." + chainId + ".startBlocks." + name),
+		}
+	}
+	return contracts, nil
+}
+
+// loadABI reads the abi field of a brownie build artifact
+func loadABI(path string) (abi.ABI, error) {
+	abiBytes, err := readFile(path)
+	if err != nil {
+		return abi.ABI{}, err
+	}
+	var result map[string]json.RawMessage
+	err = json.Unmarshal(abiBytes, &result)
+	if err != nil {
+		return abi.ABI{}, err
+	}
+	return abi.JSON(strings.NewReader(string(result["abi"])))
+}
+
+func readFile(path string) ([]byte, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return ioutil.ReadAll(file)
+}
@@ -0,0 +1,164 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	ErrNotDeployed    = errors.New("contract is not deployed on this chain")
+	ErrMethodNotFound = errors.New("method is not in the loaded contract ABI")
+	ErrNoSigner       = errors.New("no signer is configured")
+	ErrEventNotFound  = errors.New("event is not in the loaded contract ABI")
+)
+
+// PackError is returned when the arguments do not match the method ABI
+type PackError struct {
+	Method string
+	Err    error
+}
+
+func (e *PackError) Error() string {
+	return fmt.Sprintf("Cannot Pack Method %s: %v", e.Method, e.Err)
+}
+
+func (e *PackError) Unwrap() error { return e.Err }
+
+// RpcError is returned when the node cannot be reached or rejects the request
+type RpcError struct {
+	Method string
+	Err    error
+}
+
+func (e *RpcError) Error() string {
+	return fmt.Sprintf("Node call %s failed: %v", e.Method, e.Err)
+}
+
+func (e *RpcError) Unwrap() error { return e.Err }
+
+// RevertError is returned when the EVM reverted the call, Reason holds the decoded revert string
+type RevertError struct {
+	Method    string
+	Reason    string
+	PanicCode *big.Int
+	Data      []byte
+	Err       error
+}
+
+func (e *RevertError) Error() string {
+	if e.Reason == "" {
+		return fmt.Sprintf("%s reverted", e.Method)
+	}
+	return fmt.Sprintf("%s reverted: %s", e.Method, e.Reason)
+}
+
+func (e *RevertError) Unwrap() error { return e.Err }
+
+// UnpackError is returned when the returned data does not match the method ABI
+type UnpackError struct {
+	Method string
+	Err    error
+}
+
+func (e *UnpackError) Error() string {
+	return fmt.Sprintf("Cannot Unpack Result of %s: %v", e.Method, e.Err)
+}
+
+func (e *UnpackError) Unwrap() error { return e.Err }
+
+// rpcErrorFor wraps an error returned by the node as RevertError or RpcError
+func rpcErrorFor(method string, err error) error {
+	if isRevert(err) {
+		revertErr := &RevertError{Method: method, Err: err}
+		if data, ok := revertData(err); ok {
+			revertErr.DecodeRevert(data)
+		}
+		if revertErr.Reason == "" && revertErr.PanicCode == nil {
+			revertErr.Reason = strings.TrimPrefix(strings.TrimPrefix(err.Error(), "execution reverted"), ": ")
+		}
+		return revertErr
+	}
+	if isMissingState(err) {
+		return &RpcError{Method: method, Err: fmt.Errorf("%w: %v", ErrStateUnavailable, err)}
+	}
+	return &RpcError{Method: method, Err: err}
+}
+
+func isRevert(err error) bool {
+	// geth and most clients answer reverted calls with code 3
+	var codeErr interface{ ErrorCode() int }
+	if errors.As(err, &codeErr) && codeErr.ErrorCode() == 3 {
+		return true
+	}
+	return strings.HasPrefix(err.Error(), "execution reverted")
+}
+
+// StatusError converts errors returned by utils into gRPC status errors
+func StatusError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	var packErr *PackError
+	var rpcErr *RpcError
+	var revertErr *RevertError
+	var unpackErr *UnpackError
+	switch {
+	case errors.As(err, &packErr):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, ErrInvalidBlock):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, ErrStateUnavailable):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, ErrBlockNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.As(err, &rpcErr):
+		return status.Error(codes.Unavailable, err.Error())
+	case errors.As(err, &revertErr):
+		return revertStatus(revertErr).Err()
+	case errors.As(err, &unpackErr):
+		return status.Error(codes.Internal, err.Error())
+	case errors.Is(err, ErrNoSigner):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, ErrFeeTooHigh):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, ErrTxNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, ErrNotDeployed), errors.Is(err, ErrMethodNotFound), errors.Is(err, ErrEventNotFound):
+		return status.Error(codes.Unimplemented, err.Error())
+	}
+	return status.Error(codes.Internal, err.Error())
+}
+
+// revertStatus attaches the decoded revert as an ErrorInfo detail
+func revertStatus(revertErr *RevertError) *status.Status {
+	st := status.New(codes.FailedPrecondition, revertErr.Error())
+	metadata := map[string]string{
+		"method": revertErr.Method,
+		"reason": revertErr.Reason,
+	}
+	if revertErr.PanicCode != nil {
+		metadata["panic_code"] = revertErr.PanicCode.String()
+	}
+	if len(revertErr.Data) > 0 {
+		metadata["data"] = hexutil.Encode(revertErr.Data)
+	}
+	detailed, err := st.WithDetails(&errdetails.ErrorInfo{
+		Reason:   "EXECUTION_REVERTED",
+		Domain:   "aniwar",
+		Metadata: metadata,
+	})
+	if err != nil {
+		return st
+	}
+	return detailed
+}
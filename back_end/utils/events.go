@@ -0,0 +1,116 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Public nodes reject eth_getLogs over a few thousand blocks
+const DEFAULT_LOG_RANGE = 5000
+
+// Event is a log decoded with the ABI of the contract that emitted it
+type Event struct {
+	Name string
+	Log  types.Log
+	// Indexed and non indexed arguments by name
+	Args map[string]interface{}
+}
+
+// FilterEvents returns the eventNames logs of contractName between fromBlock and toBlock (latest when nil),
+// oldest first. topics filter the indexed arguments after the event signature, as in ethereum.FilterQuery
+func FilterEvents(ctx context.Context, config Config, contractName string, eventNames []string, fromBlock uint64, toBlock *big.Int, topics ...[]common.Hash) ([]Event, error) {
+	contract, err := config.GetContract(contractName)
+	if err != nil {
+		return nil, err
+	}
+	var ids []common.Hash
+	for _, name := range eventNames {
+		event, ok := contract.ABI.Events[name]
+		if !ok {
+			return nil, fmt.Errorf("%s.%s: %w", contract.Name, name, ErrEventNotFound)
+		}
+		ids = append(ids, event.ID)
+	}
+	if fromBlock < contract.StartBlock {
+		fromBlock = contract.StartBlock
+	}
+	var last uint64
+	if toBlock == nil {
+		header, err := config.Client.HeaderByNumber(ctx, nil)
+		if err != nil {
+			return nil, &RpcError{Method: "eth_getBlockByNumber", Err: err}
+		}
+		last = header.Number.Uint64()
+	} else {
+		last = toBlock.Uint64()
+	}
+	logRange := config.LogRange
+	if logRange == 0 {
+		logRange = DEFAULT_LOG_RANGE
+	}
+
+	var events []Event
+	for start := fromBlock; start <= last; start += logRange {
+		end := start + logRange - 1
+		if end > last {
+			end = last
+		}
+		logs, err := config.Client.FilterLogs(ctx, ethereum.FilterQuery{
+			FromBlock: new(big.Int).SetUint64(start),
+			ToBlock:   new(big.Int).SetUint64(end),
+			Addresses: []common.Address{contract.Address},
+			Topics:    append([][]common.Hash{ids}, topics...),
+		})
+		if err != nil {
+			return nil, &RpcError{Method: "eth_getLogs", Err: err}
+		}
+		for _, log := range logs {
+			event, err := DecodeEvent(contract, log)
+			if err != nil {
+				return nil, err
+			}
+			events = append(events, event)
+		}
+	}
+	return events, nil
+}
+
+// DecodeEvent decodes a log emitted by contract
+func DecodeEvent(contract Contract, log types.Log) (Event, error) {
+	if len(log.Topics) == 0 {
+		return Event{}, fmt.Errorf("%s: anonymous log: %w", contract.Name, ErrEventNotFound)
+	}
+	abiEvent, err := contract.ABI.EventByID(log.Topics[0])
+	if err != nil {
+		return Event{}, fmt.Errorf("%s: %s: %w", contract.Name, log.Topics[0].Hex(), ErrEventNotFound)
+	}
+	args := make(map[string]interface{})
+	if len(log.Data) > 0 {
+		err = contract.ABI.UnpackIntoMap(args, abiEvent.Name, log.Data)
+		if err != nil {
+			return Event{}, &UnpackError{Method: abiEvent.Name, Err: err}
+		}
+	}
+	var indexed abi.Arguments
+	for _, arg := range abiEvent.Inputs {
+		if arg.Indexed {
+			indexed = append(indexed, arg)
+		}
+	}
+	err = abi.ParseTopicsIntoMap(args, indexed, log.Topics[1:])
+	if err != nil {
+		return Event{}, &UnpackError{Method: abiEvent.Name, Err: err}
+	}
+	return Event{Name: abiEvent.Name, Log: log, Args: args}, nil
+}
+
+// AddressTopic is the topic of an indexed address argument
+func AddressTopic(address common.Address) common.Hash {
+	return common.BytesToHash(address.Bytes())
+}
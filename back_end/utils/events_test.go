@@ -0,0 +1,48 @@
+package utils
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestFilterEvents(t *testing.T) {
+	config, backend := newTestConfig(t)
+	// one block per request, the transfers span several ranges
+	config.LogRange = 1
+	recipient := common.HexToAddress("0x0000000000000000000000000000000000000007")
+	for i := 1; i <= 3; i++ {
+		if _, err := CallMethods(config, ANIWAR_TOKEN, "transfer", big.NewInt(0), recipient, big.NewInt(int64(i))); err != nil {
+			t.Fatal(err)
+		}
+		backend.Commit()
+	}
+	other := common.HexToAddress("0x0000000000000000000000000000000000000008")
+	if _, err := CallMethods(config, ANIWAR_TOKEN, "transfer", big.NewInt(0), other, big.NewInt(9)); err != nil {
+		t.Fatal(err)
+	}
+	backend.Commit()
+
+	// Transfer(address indexed from, address indexed to, uint256 value)
+	events, err := FilterEvents(context.Background(), config, ANIWAR_TOKEN, []string{"Transfer"}, 0, nil, nil, []common.Hash{AddressTopic(recipient)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 3 {
+		t.Fatalf("got %d events, want 3", len(events))
+	}
+	for i, event := range events {
+		if event.Name != "Transfer" || event.Args["to"].(common.Address) != recipient {
+			t.Errorf("event %d = %s to %v", i, event.Name, event.Args["to"])
+		}
+		if value := event.Args["value"].(*big.Int); value.Int64() != int64(i+1) {
+			t.Errorf("event %d value = %v, want %d", i, value, i+1)
+		}
+	}
+
+	if _, err := FilterEvents(context.Background(), config, ANIWAR_TOKEN, []string{"Missing"}, 0, nil); err == nil {
+		t.Error("filtered an event missing from the ABI")
+	}
+}
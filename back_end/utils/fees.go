@@ -0,0 +1,129 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/spf13/viper"
+)
+
+const (
+	// Dynamic fee when the chain has a base fee, legacy otherwise
+	TX_TYPE_AUTO    = "auto"
+	TX_TYPE_LEGACY  = "legacy"
+	TX_TYPE_DYNAMIC = "dynamic"
+)
+
+var ErrFeeTooHigh = errors.New("network fee is above the configured maximum")
+
+// FeeConfig is read from chains.<chainId> in config.yaml, fees are in gwei there. Nil caps are unlimited
+type FeeConfig struct {
+	TxType               string
+	MaxFeePerGas         *big.Int
+	MaxPriorityFeePerGas *big.Int
+	MaxGasPrice          *big.Int
+}
+
+func loadFeeConfig(chainId *big.Int) (FeeConfig, error) {
+	key := "chains." + chainId.String() + "."
+	fees := FeeConfig{TxType: viper.GetString(key + "txType")}
+	switch fees.TxType {
+	case "":
+		fees.TxType = TX_TYPE_AUTO
+	case TX_TYPE_AUTO, TX_TYPE_LEGACY, TX_TYPE_DYNAMIC:
+	default:
+		return fees, fmt.Errorf("Config: Unknown txType %q for chain %v", fees.TxType, chainId)
+	}
+	var err error
+	for _, limit := range []struct {
+		name  string
+		value **big.Int
+	}{
+		{"maxFeePerGas", &fees.MaxFeePerGas},
+		{"maxPriorityFeePerGas", &fees.MaxPriorityFeePerGas},
+		{"maxGasPrice", &fees.MaxGasPrice},
+	} {
+		*limit.value, err = gweiToWei(viper.GetString(key + limit.name))
+		if err != nil {
+			return fees, fmt.Errorf("Config: Invalid %s for chain %v: %v", limit.name, chainId, err)
+		}
+	}
+	return fees, nil
+}
+
+func gweiToWei(gwei string) (*big.Int, error) {
+	if gwei == "" {
+		return nil, nil
+	}
+	value, ok := new(big.Float).SetString(gwei)
+	if !ok || value.Sign() < 0 {
+		return nil, fmt.Errorf("%q is not a gwei amount", gwei)
+	}
+	wei, _ := value.Mul(value, big.NewFloat(params.GWei)).Int(nil)
+	return wei, nil
+}
+
+// newTxData builds a legacy or EIP-1559 transaction depending on the chain and the fee config
+func newTxData(ctx context.Context, config Config, nonce uint64, to common.Address, value *big.Int, gasLimit uint64, data []byte) (types.TxData, error) {
+	fees := config.Fees
+	txType := fees.TxType
+	var baseFee *big.Int
+	if txType != TX_TYPE_LEGACY {
+		header, err := config.Client.HeaderByNumber(ctx, nil)
+		if err != nil {
+			return nil, &RpcError{Method: "eth_getBlockByNumber", Err: err}
+		}
+		baseFee = header.BaseFee
+		if baseFee == nil && txType == TX_TYPE_DYNAMIC {
+			return nil, fmt.Errorf("chain %v has no base fee, use txType legacy", config.ChainId)
+		}
+		if baseFee != nil {
+			txType = TX_TYPE_DYNAMIC
+		}
+	}
+
+	if txType != TX_TYPE_DYNAMIC {
+		gasPrice, err := config.Client.SuggestGasPrice(ctx)
+		if err != nil {
+			return nil, &RpcError{Method: "eth_gasPrice", Err: err}
+		}
+		if fees.MaxGasPrice != nil && gasPrice.Cmp(fees.MaxGasPrice) > 0 {
+			return nil, fmt.Errorf("gas price %v wei over %v wei: %w", gasPrice, fees.MaxGasPrice, ErrFeeTooHigh)
+		}
+		return &types.LegacyTx{Nonce: nonce, To: &to, Value: value, Gas: gasLimit, GasPrice: gasPrice, Data: data}, nil
+	}
+
+	tipCap, err := config.Client.SuggestGasTipCap(ctx)
+	if err != nil {
+		return nil, &RpcError{Method: "eth_maxPriorityFeePerGas", Err: err}
+	}
+	if fees.MaxPriorityFeePerGas != nil && tipCap.Cmp(fees.MaxPriorityFeePerGas) > 0 {
+		tipCap = new(big.Int).Set(fees.MaxPriorityFeePerGas)
+	}
+	// Same margin as go-ethereum's bind package: room for the base fee to double
+	feeCap := new(big.Int).Add(tipCap, new(big.Int).Mul(baseFee, big.NewInt(2)))
+	if fees.MaxFeePerGas != nil && feeCap.Cmp(fees.MaxFeePerGas) > 0 {
+		if baseFee.Cmp(fees.MaxFeePerGas) > 0 {
+			return nil, fmt.Errorf("base fee %v wei over %v wei: %w", baseFee, fees.MaxFeePerGas, ErrFeeTooHigh)
+		}
+		feeCap = new(big.Int).Set(fees.MaxFeePerGas)
+		if tipCap.Cmp(feeCap) > 0 {
+			tipCap = new(big.Int).Set(feeCap)
+		}
+	}
+	return &types.DynamicFeeTx{
+		ChainID:   config.ChainId,
+		Nonce:     nonce,
+		GasTipCap: tipCap,
+		GasFeeCap: feeCap,
+		Gas:       gasLimit,
+		To:        &to,
+		Value:     value,
+		Data:      data,
+	}, nil
+}
@@ -0,0 +1,69 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/viper"
+)
+
+const DEFAULT_GAS_MULTIPLIER = 1.2
+
+// GasConfig sets how gas limits are derived from eth_estimateGas
+type GasConfig struct {
+	Multiplier float64
+	// Fixed limits keyed by "<contract>.<method>", the call is still estimated to catch reverts
+	Overrides map[string]uint64
+}
+
+func loadGasConfig(contracts map[string]Contract) (GasConfig, error) {
+	viper.SetDefault("gasMultiplier", DEFAULT_GAS_MULTIPLIER)
+	gas := GasConfig{
+		Multiplier: viper.GetFloat64("gasMultiplier"),
+		Overrides:  make(map[string]uint64),
+	}
+	if gas.Multiplier < 1 {
+		return gas, fmt.Errorf("Config: gasMultiplier %v must be at least 1", gas.Multiplier)
+	}
+	// viper lower cases keys, match them back to the ABI names
+	for contractKey, methods := range viper.GetStringMap("gasLimits") {
+		for _, contract := range contracts {
+			if !strings.EqualFold(contract.Name, contractKey) {
+				continue
+			}
+			methodLimits, ok := methods.(map[string]interface{})
+			if !ok {
+				return gas, fmt.Errorf("Config: gasLimits.%s must map method names to gas limits", contract.Name)
+			}
+			for methodKey := range methodLimits {
+				for name := range contract.ABI.Methods {
+					if strings.EqualFold(name, methodKey) {
+						gas.Overrides[contract.Name+"."+name] = viper.GetUint64("gasLimits." + contractKey + "." + methodKey)
+					}
+				}
+			}
+		}
+	}
+	return gas, nil
+}
+
+// estimateGas returns the gas limit for the call, refusing calls that would revert
+func estimateGas(ctx context.Context, config Config, from common.Address, contract Contract, methodName string, value *big.Int, data []byte) (uint64, error) {
+	estimate, err := config.Client.EstimateGas(ctx, ethereum.CallMsg{From: from, To: &contract.Address, Value: value, Data: data})
+	if err != nil {
+		return 0, rpcErrorFor(methodName, err)
+	}
+	if limit, ok := config.Gas.Overrides[contract.Name+"."+methodName]; ok {
+		return limit, nil
+	}
+	multiplier := config.Gas.Multiplier
+	if multiplier < 1 {
+		multiplier = DEFAULT_GAS_MULTIPLIER
+	}
+	return uint64(math.Ceil(float64(estimate) * multiplier)), nil
+}
@@ -0,0 +1,104 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/viper"
+)
+
+// Multicall3 is deployed at this address on BSC, Ethereum and most testnets, see https://www.multicall3.com
+const MULTICALL3_ADDRESS = "0xcA11bde05977b3631167028862bE2a173976CA11"
+
+// aggregate3 of Multicall3, the only method the backend uses
+const MULTICALL3_ABI = `[{"inputs":[{"components":[{"internalType":"address","name":"target","type":"address"},{"internalType":"bool","name":"allowFailure","type":"bool"},{"internalType":"bytes","name":"callData","type":"bytes"}],"internalType":"struct Multicall3.Call3[]","name":"calls","type":"tuple[]"}],"name":"aggregate3","outputs":[{"components":[{"internalType":"bool","name":"success","type":"bool"},{"internalType":"bytes","name":"returnData","type":"bytes"}],"internalType":"struct Multicall3.Result[]","name":"returnData","type":"tuple[]"}],"stateMutability":"payable","type":"function"}]`
+
+var multicallABI, _ = abi.JSON(strings.NewReader(MULTICALL3_ABI))
+
+// resolveMulticall returns chains.<chainId>.multicall, else MULTICALL3_ADDRESS when the node has code there.
+// The zero address sends the batched view calls as JSON-RPC batches
+func resolveMulticall(client Backend, chainId *big.Int) (common.Address, error) {
+	if multicall := viper.GetString("chains." + chainId.String() + ".multicall"); multicall != "" {
+		if !common.IsHexAddress(multicall) {
+			return common.Address{}, fmt.Errorf("Config: Invalid multicall address %q", multicall)
+		}
+		return common.HexToAddress(multicall), nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), CALL_TIMEOUT)
+	defer cancel()
+	address := common.HexToAddress(MULTICALL3_ADDRESS)
+	code, err := client.CodeAt(ctx, address, nil)
+	if err != nil {
+		log.Printf("Config: Cannot look for Multicall3 at %s, view calls are sent as JSON-RPC batches: %v", MULTICALL3_ADDRESS, err)
+		return common.Address{}, nil
+	}
+	if len(code) == 0 {
+		return common.Address{}, nil
+	}
+	return address, nil
+}
+
+// multicallCall and multicallResult mirror the Call3 and Result structs of Multicall3
+type multicallCall struct {
+	Target       common.Address
+	AllowFailure bool
+	CallData     []byte
+}
+
+type multicallResult struct {
+	Success    bool
+	ReturnData []byte
+}
+
+// multicallViewMethods sends calls as one aggregate3 eth_call. Every sub-call may fail on its own:
+// a revert fills the Err of its call only
+func multicallViewMethods(config Config, calls []*ViewCall) error {
+	packed := packCalls(config, calls)
+	if len(packed) == 0 {
+		return nil
+	}
+	subCalls := make([]multicallCall, len(packed))
+	for i, p := range packed {
+		subCalls[i] = multicallCall{Target: p.contract.Address, AllowFailure: true, CallData: p.data}
+	}
+	data, err := multicallABI.Pack("aggregate3", subCalls)
+	if err != nil {
+		return &PackError{Method: "aggregate3", Err: err}
+	}
+
+	d := time.Now().Add(CALL_TIMEOUT)
+	ctx, cancel := context.WithDeadline(context.Background(), d)
+	defer cancel()
+	msg := ethereum.CallMsg{From: common.HexToAddress(config.AccountAddress), To: &config.Multicall, Data: data}
+	output, err := config.Client.CallContract(ctx, msg, config.Block)
+	if err != nil {
+		return rpcErrorFor("aggregate3", err)
+	}
+	values, err := multicallABI.Unpack("aggregate3", output)
+	if err != nil {
+		return &UnpackError{Method: "aggregate3", Err: err}
+	}
+	results := *abi.ConvertType(values[0], new([]multicallResult)).(*[]multicallResult)
+	if len(results) != len(packed) {
+		return &UnpackError{Method: "aggregate3", Err: fmt.Errorf("got %d results for %d calls", len(results), len(packed))}
+	}
+
+	for i, p := range packed {
+		if !results[i].Success {
+			revertErr := &RevertError{Method: p.call.MethodName, Err: errors.New("execution reverted")}
+			revertErr.DecodeRevert(results[i].ReturnData)
+			p.call.Err = revertErr
+			continue
+		}
+		p.unpack(results[i].ReturnData)
+	}
+	return nil
+}
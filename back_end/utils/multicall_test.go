@@ -0,0 +1,115 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/viper"
+)
+
+// multicallBackend runs aggregate3 calls to address against the wrapped backend, like Multicall3 would
+type multicallBackend struct {
+	Backend
+	address common.Address
+	calls   int
+}
+
+func (b *multicallBackend) CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	if msg.To == nil || *msg.To != b.address {
+		return b.Backend.CallContract(ctx, msg, blockNumber)
+	}
+	b.calls++
+	values, err := multicallABI.Methods["aggregate3"].Inputs.Unpack(msg.Data[4:])
+	if err != nil {
+		return nil, err
+	}
+	var results []multicallResult
+	for _, call := range values[0].([]struct {
+		Target       common.Address `json:"target"`
+		AllowFailure bool           `json:"allowFailure"`
+		CallData     []byte         `json:"callData"`
+	}) {
+		target := call.Target
+		output, err := b.Backend.CallContract(ctx, ethereum.CallMsg{From: b.address, To: &target, Data: call.CallData}, blockNumber)
+		if err != nil {
+			data, _ := revertData(err)
+			results = append(results, multicallResult{Success: false, ReturnData: data})
+			continue
+		}
+		results = append(results, multicallResult{Success: true, ReturnData: output})
+	}
+	return multicallABI.Methods["aggregate3"].Outputs.Pack(results)
+}
+
+// CodeAt reports code at address, the calls to it are answered by CallContract
+func (b *multicallBackend) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) ([]byte, error) {
+	if contract == b.address {
+		return []byte{0x00}, nil
+	}
+	return b.Backend.CodeAt(ctx, contract, blockNumber)
+}
+
+func TestResolveMulticall(t *testing.T) {
+	config, _ := newTestConfig(t)
+	chainId := config.ChainId
+	defaultAddress := common.HexToAddress(MULTICALL3_ADDRESS)
+
+	if multicall, err := resolveMulticall(config.Client, chainId); err != nil || multicall != (common.Address{}) {
+		t.Errorf("without Multicall3: %s, err %v", multicall.Hex(), err)
+	}
+	deployed := &multicallBackend{Backend: config.Client, address: defaultAddress}
+	if multicall, err := resolveMulticall(deployed, chainId); err != nil || multicall != defaultAddress {
+		t.Errorf("with Multicall3: %s, err %v", multicall.Hex(), err)
+	}
+
+	key := "chains." + chainId.String() + ".multicall"
+	t.Cleanup(func() { viper.Set(key, "") })
+	viper.Set(key, "0x0000000000000000000000000000000000000007")
+	if multicall, err := resolveMulticall(deployed, chainId); err != nil || multicall != common.HexToAddress("0x0000000000000000000000000000000000000007") {
+		t.Errorf("configured: %s, err %v", multicall.Hex(), err)
+	}
+	viper.Set(key, "0x07")
+	if _, err := resolveMulticall(deployed, chainId); err == nil {
+		t.Error("invalid address is accepted")
+	}
+}
+
+func TestBatchCallViewMethodsMulticall(t *testing.T) {
+	config, _ := newTestConfig(t)
+	backend := &multicallBackend{Backend: config.Client, address: common.HexToAddress(MULTICALL3_ADDRESS)}
+	config.Client = backend
+	config.Multicall = backend.address
+	signer := config.Signer.Address()
+	stranger := common.HexToAddress("0x0000000000000000000000000000000000000007")
+
+	calls := []*ViewCall{
+		{ContractName: ANIWAR_TOKEN, MethodName: "balanceOf", Args: []interface{}{signer}},
+		// no allowance: reverts without sinking the other calls
+		{ContractName: ANIWAR_TOKEN, MethodName: "transferFrom", Args: []interface{}{stranger, signer, big.NewInt(1)}},
+		{ContractName: ANIWAR_TOKEN, MethodName: "noSuchMethod"},
+		{ContractName: ANIWAR_TOKEN, MethodName: "decimals"},
+	}
+	if err := BatchCallViewMethods(config, calls); err != nil {
+		t.Fatal(err)
+	}
+	if backend.calls != 1 {
+		t.Errorf("sent %d aggregate3 calls, want 1", backend.calls)
+	}
+	if calls[0].Err != nil || calls[0].Result[0].(*big.Int).Sign() <= 0 {
+		t.Errorf("balanceOf = %v, %v", calls[0].Result, calls[0].Err)
+	}
+	var revertErr *RevertError
+	if !errors.As(calls[1].Err, &revertErr) || revertErr.Reason == "" {
+		t.Errorf("transferFrom err = %v, want a decoded revert", calls[1].Err)
+	}
+	if !errors.Is(calls[2].Err, ErrMethodNotFound) {
+		t.Errorf("noSuchMethod err = %v", calls[2].Err)
+	}
+	if calls[3].Err != nil || calls[3].Result[0].(uint8) != 18 {
+		t.Errorf("decimals = %v, %v", calls[3].Result, calls[3].Err)
+	}
+}
@@ -0,0 +1,116 @@
+package utils
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// NonceManager hands out the nonces of the backend signers so concurrent sends do not collide
+type NonceManager struct {
+	client Backend
+
+	mu       sync.Mutex
+	accounts map[common.Address]*accountNonce
+}
+
+type accountNonce struct {
+	mu     sync.Mutex
+	synced bool
+	next   uint64
+	// Nonces handed out but never mined, reused before next
+	gaps []uint64
+}
+
+func NewNonceManager(client Backend) *NonceManager {
+	return &NonceManager{
+		client:   client,
+		accounts: make(map[common.Address]*accountNonce),
+	}
+}
+
+func (m *NonceManager) account(from common.Address) *accountNonce {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	account, ok := m.accounts[from]
+	if !ok {
+		account = &accountNonce{}
+		m.accounts[from] = account
+	}
+	return account
+}
+
+// Next returns the nonce to sign the next transaction of from with
+func (m *NonceManager) Next(ctx context.Context, from common.Address) (uint64, error) {
+	account := m.account(from)
+	account.mu.Lock()
+	defer account.mu.Unlock()
+
+	if !account.synced {
+		nonce, err := m.client.PendingNonceAt(ctx, from)
+		if err != nil {
+			return 0, &RpcError{Method: "eth_getTransactionCount", Err: err}
+		}
+		account.next = nonce
+		account.gaps = nil
+		account.synced = true
+	}
+	if len(account.gaps) > 0 {
+		nonce := account.gaps[0]
+		account.gaps = account.gaps[1:]
+		return nonce, nil
+	}
+	nonce := account.next
+	account.next++
+	return nonce, nil
+}
+
+// Release gives back a nonce whose transaction will never be mined, either because it
+// could not be broadcast or because it was dropped, so the next send fills the gap
+func (m *NonceManager) Release(from common.Address, nonce uint64) {
+	account := m.account(from)
+	account.mu.Lock()
+	defer account.mu.Unlock()
+
+	if !account.synced || nonce >= account.next {
+		return
+	}
+	for _, gap := range account.gaps {
+		if gap == nonce {
+			return
+		}
+	}
+	account.gaps = append(account.gaps, nonce)
+	sort.Slice(account.gaps, func(i, j int) bool { return account.gaps[i] < account.gaps[j] })
+}
+
+// Resync makes the next call to Next read the nonce from the node again
+func (m *NonceManager) Resync(from common.Address) {
+	account := m.account(from)
+	account.mu.Lock()
+	defer account.mu.Unlock()
+	account.synced = false
+}
+
+// Failed handles a SendTransaction error for nonce: nonce errors mean the local
+// state is off and needs a resync, any other error leaves the nonce unused
+func (m *NonceManager) Failed(from common.Address, nonce uint64, err error) {
+	if isNonceError(err) {
+		m.Resync(from)
+		return
+	}
+	m.Release(from, nonce)
+}
+
+func isNonceError(err error) bool {
+	message := strings.ToLower(err.Error())
+	for _, nonceError := range []string{"nonce too low", "nonce too high", "already known", "replacement transaction underpriced", "invalid transaction nonce"} {
+		if strings.Contains(message, nonceError) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,192 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sync"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/mineloop99/new-token/back_end/utils/utilstest"
+)
+
+// poolBackend queues transactions sent ahead of their nonce like a node txpool does,
+// the SimulatedBackend alone panics when nonces arrive out of order
+type poolBackend struct {
+	*backends.SimulatedBackend
+
+	mu     sync.Mutex
+	queued map[common.Address]map[uint64]*types.Transaction
+}
+
+func (b *poolBackend) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	from, err := types.Sender(types.LatestSignerForChainID(tx.ChainId()), tx)
+	if err != nil {
+		return err
+	}
+	pending, err := b.SimulatedBackend.PendingNonceAt(ctx, from)
+	if err != nil {
+		return err
+	}
+	if tx.Nonce() < pending {
+		return errors.New("nonce too low")
+	}
+	if b.queued[from] == nil {
+		b.queued[from] = make(map[uint64]*types.Transaction)
+	}
+	if _, ok := b.queued[from][tx.Nonce()]; ok {
+		return errors.New("already known")
+	}
+	b.queued[from][tx.Nonce()] = tx
+	for {
+		next, ok := b.queued[from][pending]
+		if !ok {
+			return nil
+		}
+		delete(b.queued[from], pending)
+		if err := b.SimulatedBackend.SendTransaction(ctx, next); err != nil {
+			return err
+		}
+		pending++
+	}
+}
+
+// failingBackend fails the first SendTransaction calls without broadcasting them
+type failingBackend struct {
+	*poolBackend
+	failures int
+	err      error
+}
+
+func (b *failingBackend) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	b.mu.Lock()
+	if b.failures > 0 {
+		b.failures--
+		b.mu.Unlock()
+		return b.err
+	}
+	b.mu.Unlock()
+	return b.poolBackend.SendTransaction(ctx, tx)
+}
+
+// newTestConfig deploys AniwarToken from chain-info on a simulated chain, signer holds the whole supply
+func newTestConfig(t *testing.T) (Config, *poolBackend) {
+	t.Helper()
+	chain := utilstest.NewChain(t)
+	backend := &poolBackend{SimulatedBackend: chain.SimulatedBackend, queued: make(map[common.Address]map[uint64]*types.Transaction)}
+
+	address, contractABI := chain.Deploy(t, ANIWAR_TOKEN)
+	config := Config{
+		AccountAddress: chain.Deployer.Hex(),
+		Signer:         NewKeySigner(chain.Key),
+		ChainId:        utilstest.ChainId(),
+		Client:         backend,
+		Contracts:      map[string]Contract{ANIWAR_TOKEN: {Name: ANIWAR_TOKEN, Address: address, ABI: contractABI}},
+	}
+	config.Nonces = NewNonceManager(backend)
+	config.Tracker = NewTxTracker(config.Client, config.Nonces, 1, 0, 0)
+	return config, backend
+}
+
+func TestConcurrentCallMethods(t *testing.T) {
+	config, backend := newTestConfig(t)
+	const sends = 50
+
+	var wg sync.WaitGroup
+	errs := make(chan error, sends)
+	nonces := make(chan uint64, sends)
+	for i := 0; i < sends; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			recipient := common.BigToAddress(big.NewInt(int64(i + 1)))
+			tx, err := CallMethods(config, ANIWAR_TOKEN, "transfer", big.NewInt(0), recipient, big.NewInt(int64(i+1)))
+			if err != nil {
+				errs <- err
+				return
+			}
+			nonces <- tx.Nonce()
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+	close(nonces)
+	for err := range errs {
+		t.Errorf("CallMethods: %v", err)
+	}
+	seen := make(map[uint64]bool)
+	for nonce := range nonces {
+		if seen[nonce] {
+			t.Errorf("nonce %d used twice", nonce)
+		}
+		seen[nonce] = true
+	}
+	backend.Commit()
+
+	for i := 0; i < sends; i++ {
+		recipient := common.BigToAddress(big.NewInt(int64(i + 1)))
+		result, err := CallViewMethods(config, ANIWAR_TOKEN, "balanceOf", big.NewInt(0), recipient)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if balance := result[0].(*big.Int); balance.Int64() != int64(i+1) {
+			t.Errorf("balance of %v = %v, want %d", recipient, balance, i+1)
+		}
+	}
+}
+
+func TestNonceGapIsFilled(t *testing.T) {
+	config, backend := newTestConfig(t)
+	failing := &failingBackend{poolBackend: backend, failures: 1, err: errors.New("connection reset")}
+	config.Client = failing
+	recipient := common.HexToAddress("0x0000000000000000000000000000000000000001")
+
+	_, err := CallMethods(config, ANIWAR_TOKEN, "transfer", big.NewInt(0), recipient, big.NewInt(1))
+	var rpcErr *RpcError
+	if !errors.As(err, &rpcErr) {
+		t.Fatalf("first send: got %v, want RpcError", err)
+	}
+	tx, err := CallMethods(config, ANIWAR_TOKEN, "transfer", big.NewInt(0), recipient, big.NewInt(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the deployment used nonce 0, the failed send released nonce 1
+	if tx.Nonce() != 1 {
+		t.Errorf("nonce = %d, want 1", tx.Nonce())
+	}
+}
+
+func TestNonceResyncAfterExternalSend(t *testing.T) {
+	config, backend := newTestConfig(t)
+	signer := common.HexToAddress(config.AccountAddress)
+	recipient := common.HexToAddress("0x0000000000000000000000000000000000000001")
+
+	if _, err := CallMethods(config, ANIWAR_TOKEN, "transfer", big.NewInt(0), recipient, big.NewInt(1)); err != nil {
+		t.Fatal(err)
+	}
+	// the same key sends from somewhere else, the cached nonce is now too low
+	key := config.Signer.(*KeySigner).key
+	pending, _ := backend.PendingNonceAt(context.Background(), signer)
+	external, _ := types.SignTx(types.NewTransaction(pending, recipient, big.NewInt(1), 21000, big.NewInt(params.GWei), nil), types.NewEIP155Signer(config.ChainId), key)
+	if err := backend.SendTransaction(context.Background(), external); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err := CallMethods(config, ANIWAR_TOKEN, "transfer", big.NewInt(0), recipient, big.NewInt(1))
+	if err == nil {
+		t.Fatal("send with a stale nonce succeeded")
+	}
+	tx, err := CallMethods(config, ANIWAR_TOKEN, "transfer", big.NewInt(0), recipient, big.NewInt(1))
+	if err != nil {
+		t.Fatalf("send after resync: %v", err)
+	}
+	if tx.Nonce() != pending+1 {
+		t.Errorf("nonce = %d, want %d", tx.Nonce(), pending+1)
+	}
+}
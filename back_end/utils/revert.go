@@ -0,0 +1,104 @@
+package utils
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+var (
+	errorSelector = crypto.Keccak256([]byte("Error(string)"))[:4]
+	panicSelector = crypto.Keccak256([]byte("Panic(uint256)"))[:4]
+)
+
+// Solidity panic codes, see https://docs.soliditylang.org/en/latest/control-structures.html#panic-via-assert-and-error-via-require
+var panicReasons = map[uint64]string{
+	0x00: "generic compiler panic",
+	0x01: "assert failed",
+	0x11: "arithmetic overflow or underflow",
+	0x12: "division or modulo by zero",
+	0x21: "invalid enum value",
+	0x22: "invalid storage byte array encoding",
+	0x31: "pop on empty array",
+	0x32: "array index out of bounds",
+	0x41: "out of memory",
+	0x51: "call to zero-initialized function",
+}
+
+// DecodeRevert decodes Error(string) and Panic(uint256) revert payloads into e
+func (e *RevertError) DecodeRevert(data []byte) {
+	e.Data = data
+	if len(data) < 4 {
+		return
+	}
+	uint256Type, _ := abi.NewType("uint256", "", nil)
+	stringType, _ := abi.NewType("string", "", nil)
+	switch {
+	case bytes.Equal(data[:4], errorSelector):
+		values, err := (abi.Arguments{{Type: stringType}}).Unpack(data[4:])
+		if err == nil {
+			e.Reason = values[0].(string)
+		}
+	case bytes.Equal(data[:4], panicSelector):
+		values, err := (abi.Arguments{{Type: uint256Type}}).Unpack(data[4:])
+		if err == nil {
+			e.PanicCode = values[0].(*big.Int)
+			e.Reason = fmt.Sprintf("panic 0x%x", e.PanicCode)
+			if e.PanicCode.IsUint64() {
+				if reason, ok := panicReasons[e.PanicCode.Uint64()]; ok {
+					e.Reason = fmt.Sprintf("panic 0x%x: %s", e.PanicCode, reason)
+				}
+			}
+		}
+	}
+}
+
+// revertData extracts the revert payload attached to an eth_call error, if any
+func revertData(err error) ([]byte, bool) {
+	var dataErr interface{ ErrorData() interface{} }
+	if !errors.As(err, &dataErr) {
+		return nil, false
+	}
+	hexData, ok := dataErr.ErrorData().(string)
+	if !ok {
+		return nil, false
+	}
+	data, decodeErr := hexutil.Decode(hexData)
+	if decodeErr != nil {
+		return nil, false
+	}
+	return data, true
+}
+
+// ReceiptRevertError replays a failed transaction one block before it was mined to recover the revert reason
+func ReceiptRevertError(client Backend, methodName string, tx *types.Transaction, receipt *types.Receipt) error {
+	if receipt.Status == types.ReceiptStatusSuccessful {
+		return nil
+	}
+	revertErr := &RevertError{Method: methodName, Err: errors.New("transaction " + tx.Hash().Hex() + " failed")}
+
+	from, err := types.Sender(types.LatestSignerForChainID(tx.ChainId()), tx)
+	if err != nil {
+		return revertErr
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), CALL_TIMEOUT)
+	defer cancel()
+	msg := ethereum.CallMsg{From: from, To: tx.To(), Gas: tx.Gas(), Value: tx.Value(), Data: tx.Data()}
+	_, err = client.CallContract(ctx, msg, new(big.Int).Sub(receipt.BlockNumber, big.NewInt(1)))
+	if err == nil {
+		// the call succeeds on the parent state, most likely a transaction earlier in the block changed the outcome
+		return revertErr
+	}
+	if data, ok := revertData(err); ok {
+		revertErr.DecodeRevert(data)
+	}
+	return revertErr
+}
@@ -0,0 +1,174 @@
+package utils
+
+import (
+	"fmt"
+	"log"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/viper"
+)
+
+// Role checked for Ownable contracts instead of an AccessControl role
+const OWNER_ROLE = "owner"
+
+const DEFAULT_SIGNER = "default"
+
+// SignerRoute sends the listed methods of a contract from the signer holding Role
+type SignerRoute struct {
+	Contract string
+	Methods  []string
+	Signer   string
+	// Name of the contract's bytes32 role getter (PAUSER_ROLE, CREATOR_ADMIN_SERVER...), a 0x role hash or "owner"
+	Role string
+}
+
+type signerRouteConfig struct {
+	Contract string   `mapstructure:"contract"`
+	Methods  []string `mapstructure:"methods"`
+	Signer   string   `mapstructure:"signer"`
+	Role     string   `mapstructure:"role"`
+}
+
+// SignerFor returns the signer that sends methodName of contractName: the route for the
+// method, else the route for the whole contract ("*"), else the default signer
+func (config Config) SignerFor(contractName string, methodName string) (Signer, error) {
+	route, ok := config.Routes[contractName+"."+methodName]
+	if !ok {
+		route, ok = config.Routes[contractName+".*"]
+	}
+	if !ok {
+		if config.Signer == nil {
+			return nil, ErrNoSigner
+		}
+		return config.Signer, nil
+	}
+	signer, ok := config.Signers[route.Signer]
+	if !ok {
+		return nil, fmt.Errorf("%s: %w", route.Signer, ErrNoSigner)
+	}
+	return signer, nil
+}
+
+// loadSigners reads the named signers. The single signer or privateKey of older configs is named "default"
+func loadSigners() (map[string]Signer, Signer, error) {
+	signers := make(map[string]Signer)
+	// viper lower cases the names
+	for name := range viper.GetStringMap("signers") {
+		signer, err := loadSigner("signers." + name)
+		if err != nil {
+			return nil, nil, err
+		}
+		signers[name] = signer
+	}
+	if viper.IsSet("signer") {
+		signer, err := loadSigner("signer")
+		if err != nil {
+			return nil, nil, err
+		}
+		signers[DEFAULT_SIGNER] = signer
+	} else if viper.GetString("privateKey") != "" {
+		log.Println("Config: privateKey is deprecated, configure a signer")
+		signer, err := NewHexKeySigner(viper.GetString("privateKey"))
+		if err != nil {
+			return nil, nil, fmt.Errorf("Config: privateKey: %v", err)
+		}
+		signers[DEFAULT_SIGNER] = signer
+	}
+
+	defaultName := strings.ToLower(viper.GetString("defaultSigner"))
+	if defaultName == "" {
+		defaultName = DEFAULT_SIGNER
+	}
+	defaultSigner, ok := signers[defaultName]
+	if !ok && viper.IsSet("defaultSigner") {
+		return nil, nil, fmt.Errorf("Config: defaultSigner %q is not in signers", defaultName)
+	}
+	return signers, defaultSigner, nil
+}
+
+func loadSignerRoutes(signers map[string]Signer, contracts map[string]Contract) (map[string]SignerRoute, error) {
+	var routeConfigs []signerRouteConfig
+	err := viper.UnmarshalKey("signerRoutes", &routeConfigs)
+	if err != nil {
+		return nil, fmt.Errorf("Config: Cannot read signerRoutes: %v", err)
+	}
+	routes := make(map[string]SignerRoute)
+	for _, routeConfig := range routeConfigs {
+		route := SignerRoute(routeConfig)
+		route.Signer = strings.ToLower(route.Signer)
+		if _, ok := signers[route.Signer]; !ok {
+			return nil, fmt.Errorf("Config: signerRoutes: signer %q of %s is not in signers", route.Signer, route.Contract)
+		}
+		contract, ok := contracts[route.Contract]
+		if !ok {
+			return nil, fmt.Errorf("Config: signerRoutes: %s: %w", route.Contract, ErrNotDeployed)
+		}
+		if len(route.Methods) == 0 {
+			route.Methods = []string{"*"}
+		}
+		for _, method := range route.Methods {
+			if _, ok := contract.ABI.Methods[method]; !ok && method != "*" {
+				return nil, fmt.Errorf("Config: signerRoutes: %s.%s: %w", route.Contract, method, ErrMethodNotFound)
+			}
+			key := route.Contract + "." + method
+			if _, ok := routes[key]; ok {
+				return nil, fmt.Errorf("Config: signerRoutes: %s is routed twice", key)
+			}
+			routes[key] = route
+		}
+	}
+	return routes, nil
+}
+
+// VerifySignerRoles checks on chain that every routed signer holds the role of its route
+func VerifySignerRoles(config Config) error {
+	checked := make(map[string]bool)
+	for _, route := range config.Routes {
+		key := route.Contract + "/" + route.Signer + "/" + route.Role
+		if route.Role == "" || checked[key] {
+			continue
+		}
+		checked[key] = true
+		address := config.Signers[route.Signer].Address()
+		held, err := hasRole(config, route.Contract, route.Role, address)
+		if err != nil {
+			return fmt.Errorf("Config: Cannot check role %s of signer %s on %s: %v", route.Role, route.Signer, route.Contract, err)
+		}
+		if !held {
+			return fmt.Errorf("Config: Signer %s (%s) does not hold %s on %s", route.Signer, address.Hex(), route.Role, route.Contract)
+		}
+	}
+	return nil
+}
+
+func hasRole(config Config, contractName string, role string, address common.Address) (bool, error) {
+	if role == OWNER_ROLE {
+		result, err := CallViewMethods(config, contractName, "owner", big.NewInt(0))
+		if err != nil {
+			return false, err
+		}
+		return result[0].(common.Address) == address, nil
+	}
+
+	var roleHash [32]byte
+	if strings.HasPrefix(role, "0x") {
+		copy(roleHash[:], common.FromHex(role))
+	} else {
+		result, err := CallViewMethods(config, contractName, role, big.NewInt(0))
+		if err != nil {
+			return false, err
+		}
+		hash, ok := result[0].([32]byte)
+		if !ok {
+			return false, fmt.Errorf("%s is not a bytes32 role getter", role)
+		}
+		roleHash = hash
+	}
+	result, err := CallViewMethods(config, contractName, "hasRole", big.NewInt(0), roleHash, address)
+	if err != nil {
+		return false, err
+	}
+	return result[0].(bool), nil
+}
@@ -0,0 +1,31 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func TestVerifySignerRoles(t *testing.T) {
+	config, _ := newTestConfig(t)
+	otherKey, _ := crypto.GenerateKey()
+	config.Signers = map[string]Signer{
+		"pauser": config.Signer,
+		"other":  NewKeySigner(otherKey),
+	}
+	config.Routes = map[string]SignerRoute{
+		ANIWAR_TOKEN + ".pause": {Contract: ANIWAR_TOKEN, Methods: []string{"pause"}, Signer: "pauser", Role: "PAUSER_ROLE"},
+	}
+	if err := VerifySignerRoles(config); err != nil {
+		t.Fatalf("deployer should hold PAUSER_ROLE: %v", err)
+	}
+	signer, err := config.SignerFor(ANIWAR_TOKEN, "pause")
+	if err != nil || signer != config.Signers["pauser"] {
+		t.Errorf("pause routed to %v, %v", signer, err)
+	}
+
+	config.Routes[ANIWAR_TOKEN+".pause"] = SignerRoute{Contract: ANIWAR_TOKEN, Methods: []string{"pause"}, Signer: "other", Role: "PAUSER_ROLE"}
+	if err := VerifySignerRoles(config); err == nil {
+		t.Error("signer without PAUSER_ROLE passed verification")
+	}
+}
@@ -0,0 +1,260 @@
+package utils
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"math/big"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/spf13/viper"
+)
+
+const (
+	SIGNER_KEY      = "key"
+	SIGNER_KEYSTORE = "keystore"
+	SIGNER_REMOTE   = "remote"
+)
+
+// Signer signs the transactions the backend sends
+type Signer interface {
+	Address() common.Address
+	SignTx(ctx context.Context, tx *types.Transaction, chainId *big.Int) (*types.Transaction, error)
+}
+
+// KeySigner signs with a private key held in memory
+type KeySigner struct {
+	key     *ecdsa.PrivateKey
+	address common.Address
+}
+
+func NewKeySigner(key *ecdsa.PrivateKey) *KeySigner {
+	return &KeySigner{key: key, address: crypto.PubkeyToAddress(key.PublicKey)}
+}
+
+// NewHexKeySigner parses a hex private key, with or without 0x
+func NewHexKeySigner(hexKey string) (*KeySigner, error) {
+	key, err := crypto.HexToECDSA(strings.TrimPrefix(hexKey, "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("Cannot convert Private key: %v", err)
+	}
+	return NewKeySigner(key), nil
+}
+
+// NewKeystoreSigner decrypts a go-ethereum keystore JSON file
+func NewKeystoreSigner(path string, passphrase string) (*KeySigner, error) {
+	keyJson, err := readFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("Cannot read keystore %s: %v", path, err)
+	}
+	key, err := keystore.DecryptKey(keyJson, passphrase)
+	if err != nil {
+		return nil, fmt.Errorf("Cannot decrypt keystore %s: %v", path, err)
+	}
+	return NewKeySigner(key.PrivateKey), nil
+}
+
+func (s *KeySigner) Address() common.Address {
+	return s.address
+}
+
+func (s *KeySigner) SignTx(ctx context.Context, tx *types.Transaction, chainId *big.Int) (*types.Transaction, error) {
+	return types.SignTx(tx, types.LatestSignerForChainID(chainId), s.key)
+}
+
+// RemoteSignRequest is the body posted to a remote signer
+type RemoteSignRequest struct {
+	Address common.Address `json:"address"`
+	ChainId *hexutil.Big   `json:"chainId"`
+	// Binary encoding of the unsigned transaction
+	Tx hexutil.Bytes `json:"tx"`
+}
+
+type RemoteSignResponse struct {
+	SignedTx hexutil.Bytes `json:"signedTx"`
+	Error    string        `json:"error,omitempty"`
+}
+
+// RemoteSigner asks an HTTP service holding the key to sign, see RemoteSignerHandler for the protocol
+type RemoteSigner struct {
+	url     string
+	token   string
+	address common.Address
+	client  *http.Client
+}
+
+func NewRemoteSigner(url string, token string, address common.Address) *RemoteSigner {
+	return &RemoteSigner{url: url, token: token, address: address, client: &http.Client{Timeout: CALL_TIMEOUT}}
+}
+
+func (s *RemoteSigner) Address() common.Address {
+	return s.address
+}
+
+func (s *RemoteSigner) SignTx(ctx context.Context, tx *types.Transaction, chainId *big.Int) (*types.Transaction, error) {
+	unsigned, err := tx.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	body, err := json.Marshal(RemoteSignRequest{Address: s.address, ChainId: (*hexutil.Big)(chainId), Tx: unsigned})
+	if err != nil {
+		return nil, err
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	if s.token != "" {
+		request.Header.Set("Authorization", "Bearer "+s.token)
+	}
+	response, err := s.client.Do(request)
+	if err != nil {
+		return nil, fmt.Errorf("Remote signer: %v", err)
+	}
+	defer response.Body.Close()
+	var result RemoteSignResponse
+	err = json.NewDecoder(response.Body).Decode(&result)
+	if err != nil {
+		return nil, fmt.Errorf("Remote signer: Cannot decode response (HTTP %d): %v", response.StatusCode, err)
+	}
+	if result.Error != "" || response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("Remote signer: HTTP %d: %s", response.StatusCode, result.Error)
+	}
+
+	// Never trust the remote with the content: same transaction, signed by the expected account
+	signedTx := new(types.Transaction)
+	err = signedTx.UnmarshalBinary(result.SignedTx)
+	if err != nil {
+		return nil, fmt.Errorf("Remote signer: Invalid signed transaction: %v", err)
+	}
+	signer := types.LatestSignerForChainID(chainId)
+	if signer.Hash(signedTx) != signer.Hash(tx) {
+		return nil, errors.New("Remote signer: Signed transaction does not match the request")
+	}
+	from, err := types.Sender(signer, signedTx)
+	if err != nil || from != s.address {
+		return nil, fmt.Errorf("Remote signer: Transaction is not signed by %s", s.address.Hex())
+	}
+	return signedTx, nil
+}
+
+// RemoteSignerHandler serves the RemoteSigner protocol with a local signer, as a stand-in for tests
+// and development. An empty token disables authentication
+func RemoteSignerHandler(signer Signer, token string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		reply := func(code int, response RemoteSignResponse) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(code)
+			json.NewEncoder(w).Encode(response)
+		}
+		if r.Method != http.MethodPost {
+			reply(http.StatusMethodNotAllowed, RemoteSignResponse{Error: "POST only"})
+			return
+		}
+		if token != "" && r.Header.Get("Authorization") != "Bearer "+token {
+			reply(http.StatusUnauthorized, RemoteSignResponse{Error: "unauthorized"})
+			return
+		}
+		var request RemoteSignRequest
+		err := json.NewDecoder(r.Body).Decode(&request)
+		if err != nil || request.ChainId == nil {
+			reply(http.StatusBadRequest, RemoteSignResponse{Error: "invalid request"})
+			return
+		}
+		if request.Address != signer.Address() {
+			reply(http.StatusNotFound, RemoteSignResponse{Error: "unknown account " + request.Address.Hex()})
+			return
+		}
+		tx := new(types.Transaction)
+		err = tx.UnmarshalBinary(request.Tx)
+		if err != nil {
+			reply(http.StatusBadRequest, RemoteSignResponse{Error: "invalid transaction: " + err.Error()})
+			return
+		}
+		signedTx, err := signer.SignTx(r.Context(), tx, request.ChainId.ToInt())
+		if err != nil {
+			reply(http.StatusInternalServerError, RemoteSignResponse{Error: err.Error()})
+			return
+		}
+		signed, _ := signedTx.MarshalBinary()
+		reply(http.StatusOK, RemoteSignResponse{SignedTx: signed})
+	})
+}
+
+// loadSigner builds the signer described under key in config.yaml:
+//
+//	type: key       privateKeyEnv (or privateKey, for development only)
+//	type: keystore  keystore, passphraseEnv or passphraseFile
+//	type: remote    url, address, tokenEnv
+func loadSigner(key string) (Signer, error) {
+	settings := viper.Sub(key)
+	if settings == nil {
+		return nil, fmt.Errorf("Config: Missing %s", key)
+	}
+	switch signerType := settings.GetString("type"); signerType {
+	case SIGNER_KEY, "":
+		hexKey := os.Getenv(settings.GetString("privateKeyEnv"))
+		if hexKey == "" {
+			hexKey = settings.GetString("privateKey")
+			if hexKey != "" {
+				log.Printf("Config: %s reads a plain private key from config.yaml, use privateKeyEnv or a keystore outside development", key)
+			}
+		}
+		if hexKey == "" {
+			return nil, fmt.Errorf("Config: %s has no private key", key)
+		}
+		signer, err := NewHexKeySigner(hexKey)
+		if err != nil {
+			return nil, fmt.Errorf("Config: %s: %v", key, err)
+		}
+		return signer, nil
+	case SIGNER_KEYSTORE:
+		passphrase, err := readSecret(settings.GetString("passphraseEnv"), settings.GetString("passphraseFile"))
+		if err != nil {
+			return nil, fmt.Errorf("Config: %s: %v", key, err)
+		}
+		signer, err := NewKeystoreSigner(settings.GetString("keystore"), passphrase)
+		if err != nil {
+			return nil, fmt.Errorf("Config: %s: %v", key, err)
+		}
+		return signer, nil
+	case SIGNER_REMOTE:
+		address := settings.GetString("address")
+		if !common.IsHexAddress(address) {
+			return nil, fmt.Errorf("Config: %s has an invalid address %q", key, address)
+		}
+		return NewRemoteSigner(settings.GetString("url"), os.Getenv(settings.GetString("tokenEnv")), common.HexToAddress(address)), nil
+	default:
+		return nil, fmt.Errorf("Config: %s has an unknown type %q", key, signerType)
+	}
+}
+
+// readSecret reads a secret from an environment variable, or else from a file
+func readSecret(envName string, path string) (string, error) {
+	if envName != "" {
+		if secret, ok := os.LookupEnv(envName); ok {
+			return secret, nil
+		}
+	}
+	if path != "" {
+		secret, err := ioutil.ReadFile(path)
+		if err != nil {
+			return "", fmt.Errorf("Cannot read secret file: %v", err)
+		}
+		return strings.TrimRight(string(secret), "\r\n"), nil
+	}
+	return "", errors.New("passphraseEnv is not set and passphraseFile is missing")
+}
@@ -0,0 +1,100 @@
+package utils
+
+import (
+	"context"
+	"io/ioutil"
+	"math/big"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/google/uuid"
+)
+
+func testTx() *types.Transaction {
+	to := common.HexToAddress("0x0000000000000000000000000000000000000001")
+	return types.NewTx(&types.DynamicFeeTx{ChainID: big.NewInt(97), Nonce: 3, GasTipCap: big.NewInt(1), GasFeeCap: big.NewInt(2), Gas: 21000, To: &to, Value: big.NewInt(1)})
+}
+
+func assertSignedBy(t *testing.T, tx *types.Transaction, address common.Address) {
+	t.Helper()
+	from, err := types.Sender(types.LatestSignerForChainID(big.NewInt(97)), tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if from != address {
+		t.Errorf("signed by %s, want %s", from.Hex(), address.Hex())
+	}
+}
+
+func TestKeystoreSigner(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	keyJson, err := keystore.EncryptKey(&keystore.Key{Id: uuid.New(), Address: crypto.PubkeyToAddress(key.PublicKey), PrivateKey: key}, "secret", keystore.LightScryptN, keystore.LightScryptP)
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "key.json")
+	if err := ioutil.WriteFile(path, keyJson, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := NewKeystoreSigner(path, "wrong"); err == nil {
+		t.Error("keystore opened with a wrong passphrase")
+	}
+	signer, err := NewKeystoreSigner(path, "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	signedTx, err := signer.SignTx(context.Background(), testTx(), big.NewInt(97))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertSignedBy(t, signedTx, crypto.PubkeyToAddress(key.PublicKey))
+}
+
+func TestRemoteSigner(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	local := NewKeySigner(key)
+	server := httptest.NewServer(RemoteSignerHandler(local, "token"))
+	defer server.Close()
+
+	signedTx, err := NewRemoteSigner(server.URL, "token", local.Address()).SignTx(context.Background(), testTx(), big.NewInt(97))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertSignedBy(t, signedTx, local.Address())
+
+	if _, err := NewRemoteSigner(server.URL, "bad token", local.Address()).SignTx(context.Background(), testTx(), big.NewInt(97)); err == nil {
+		t.Error("remote signer accepted a bad token")
+	}
+	other := common.HexToAddress("0x0000000000000000000000000000000000000002")
+	if _, err := NewRemoteSigner(server.URL, "token", other).SignTx(context.Background(), testTx(), big.NewInt(97)); err == nil {
+		t.Error("remote signer signed for an unknown account")
+	}
+}
+
+func TestRemoteSignerRejectsOtherSigner(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	impostorKey, _ := crypto.GenerateKey()
+	local := NewKeySigner(key)
+	// the remote answers with a signature from another key
+	server := httptest.NewServer(RemoteSignerHandler(&fixedAddressSigner{KeySigner: NewKeySigner(impostorKey), address: local.Address()}, ""))
+	defer server.Close()
+
+	if _, err := NewRemoteSigner(server.URL, "", local.Address()).SignTx(context.Background(), testTx(), big.NewInt(97)); err == nil {
+		t.Error("accepted a transaction signed by another account")
+	}
+}
+
+type fixedAddressSigner struct {
+	*KeySigner
+	address common.Address
+}
+
+func (s *fixedAddressSigner) Address() common.Address {
+	return s.address
+}
@@ -0,0 +1,242 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// How long sent transactions are remembered
+const TRACK_RETENTION = time.Hour * 24
+
+type TxStatus int
+
+const (
+	TX_UNKNOWN TxStatus = iota
+	// Known by the node but not mined yet
+	TX_PENDING
+	// Mined with less than the required confirmations
+	TX_MINED
+	TX_CONFIRMED
+	TX_REVERTED
+	// Another transaction with the same nonce was mined
+	TX_REPLACED
+	// The node forgot the transaction before it was mined
+	TX_DROPPED
+)
+
+func (s TxStatus) String() string {
+	switch s {
+	case TX_PENDING:
+		return "PENDING"
+	case TX_MINED:
+		return "MINED"
+	case TX_CONFIRMED:
+		return "CONFIRMED"
+	case TX_REVERTED:
+		return "REVERTED"
+	case TX_REPLACED:
+		return "REPLACED"
+	case TX_DROPPED:
+		return "DROPPED"
+	}
+	return "UNKNOWN"
+}
+
+// Final reports whether the status can no longer change, short of a reorg
+func (s TxStatus) Final() bool {
+	return s == TX_CONFIRMED || s == TX_REVERTED || s == TX_REPLACED || s == TX_DROPPED
+}
+
+var ErrTxNotFound = errors.New("transaction not found")
+
+// TxState is the status of a transaction at the time it was checked
+type TxState struct {
+	Hash                  common.Hash
+	MethodName            string
+	Status                TxStatus
+	Receipt               *types.Receipt
+	Confirmations         uint64
+	RequiredConfirmations uint64
+	// Set when Status is TX_REVERTED
+	RevertErr *RevertError
+}
+
+type trackedTx struct {
+	tx         *types.Transaction
+	from       common.Address
+	methodName string
+	sentAt     time.Time
+	// Set once the nonce of a dropped transaction was handed back to the NonceManager
+	released bool
+}
+
+// TxTracker follows the transactions sent by the backend until they are confirmed, reverted, replaced or dropped
+type TxTracker struct {
+	client        Backend
+	nonces        *NonceManager
+	confirmations uint64
+	pollInterval  time.Duration
+	dropTimeout   time.Duration
+
+	mu  sync.Mutex
+	txs map[common.Hash]*trackedTx
+}
+
+// NewTxTracker follows transactions on client. The nonces of dropped transactions go back to nonces when not nil
+func NewTxTracker(client Backend, nonces *NonceManager, confirmations uint64, pollInterval time.Duration, dropTimeout time.Duration) *TxTracker {
+	if confirmations == 0 {
+		confirmations = 1
+	}
+	return &TxTracker{
+		client:        client,
+		nonces:        nonces,
+		confirmations: confirmations,
+		pollInterval:  pollInterval,
+		dropTimeout:   dropTimeout,
+		txs:           make(map[common.Hash]*trackedTx),
+	}
+}
+
+// Confirmations is the number of blocks, the mined one included, after which a block is final enough to act on
+func (t *TxTracker) Confirmations() uint64 {
+	return t.confirmations
+}
+
+func (t *TxTracker) PollInterval() time.Duration {
+	return t.pollInterval
+}
+
+// Track registers a transaction signed by from so it can be told apart from dropped or replaced ones
+func (t *TxTracker) Track(methodName string, from common.Address, tx *types.Transaction) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for hash, tracked := range t.txs {
+		if time.Since(tracked.sentAt) > TRACK_RETENTION {
+			delete(t.txs, hash)
+		}
+	}
+	t.txs[tx.Hash()] = &trackedTx{tx: tx, from: from, methodName: methodName, sentAt: time.Now()}
+}
+
+func (t *TxTracker) tracked(hash common.Hash) (*trackedTx, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	tracked, ok := t.txs[hash]
+	return tracked, ok
+}
+
+// Status checks the transaction against the node once
+func (t *TxTracker) Status(ctx context.Context, hash common.Hash) (TxState, error) {
+	client := t.client
+	state := TxState{Hash: hash, RequiredConfirmations: t.confirmations}
+	tracked, isTracked := t.tracked(hash)
+	if isTracked {
+		state.MethodName = tracked.methodName
+	}
+
+	receipt, err := client.TransactionReceipt(ctx, hash)
+	if err != nil && !errors.Is(err, ethereum.NotFound) {
+		return state, &RpcError{Method: "eth_getTransactionReceipt", Err: err}
+	}
+	if receipt != nil {
+		header, err := client.HeaderByNumber(ctx, nil)
+		if err != nil {
+			return state, &RpcError{Method: "eth_getBlockByNumber", Err: err}
+		}
+		head := header.Number.Uint64()
+		state.Receipt = receipt
+		if head >= receipt.BlockNumber.Uint64() {
+			state.Confirmations = head - receipt.BlockNumber.Uint64() + 1
+		}
+		switch {
+		case receipt.Status != types.ReceiptStatusSuccessful:
+			state.Status = TX_REVERTED
+			tx, _, err := client.TransactionByHash(ctx, hash)
+			if err == nil {
+				var revertErr *RevertError
+				if errors.As(ReceiptRevertError(client, state.MethodName, tx, receipt), &revertErr) {
+					state.RevertErr = revertErr
+				}
+			}
+		case state.Confirmations >= t.confirmations:
+			state.Status = TX_CONFIRMED
+		default:
+			state.Status = TX_MINED
+		}
+		return state, nil
+	}
+
+	tx, _, err := client.TransactionByHash(ctx, hash)
+	if err != nil && !errors.Is(err, ethereum.NotFound) {
+		return state, &RpcError{Method: "eth_getTransactionByHash", Err: err}
+	}
+	if !isTracked {
+		if tx == nil {
+			return state, fmt.Errorf("%s: %w", hash.Hex(), ErrTxNotFound)
+		}
+		state.Status = TX_PENDING
+		return state, nil
+	}
+
+	// Not mined: a mined nonce past ours means another transaction took its place
+	minedNonce, err := client.NonceAt(ctx, tracked.from, nil)
+	if err != nil {
+		return state, &RpcError{Method: "eth_getTransactionCount", Err: err}
+	}
+	switch {
+	case minedNonce > tracked.tx.Nonce():
+		// it may have been mined since the receipt was checked
+		if _, err := client.TransactionReceipt(ctx, hash); err == nil {
+			return t.Status(ctx, hash)
+		}
+		state.Status = TX_REPLACED
+	case tx == nil && time.Since(tracked.sentAt) > t.dropTimeout:
+		state.Status = TX_DROPPED
+		t.release(tracked)
+	default:
+		state.Status = TX_PENDING
+	}
+	return state, nil
+}
+
+// Wait polls the transaction until it reaches a final status, or until it is mined when untilMined is set
+func (t *TxTracker) Wait(ctx context.Context, hash common.Hash, untilMined bool) (TxState, error) {
+	ticker := time.NewTicker(t.pollInterval)
+	defer ticker.Stop()
+	for {
+		state, err := t.Status(ctx, hash)
+		var rpcErr *RpcError
+		if err != nil && !errors.As(err, &rpcErr) {
+			return state, err
+		}
+		if err == nil && (state.Status.Final() || (untilMined && state.Status == TX_MINED)) {
+			return state, nil
+		}
+		select {
+		case <-ctx.Done():
+			if err != nil {
+				return state, err
+			}
+			return state, &RpcError{Method: "wait " + hash.Hex(), Err: ctx.Err()}
+		case <-ticker.C:
+		}
+	}
+}
+
+// release hands the nonce of a dropped transaction back so the next send fills the gap
+func (t *TxTracker) release(tracked *trackedTx) {
+	t.mu.Lock()
+	released := tracked.released
+	tracked.released = true
+	t.mu.Unlock()
+	if !released && t.nonces != nil {
+		t.nonces.Release(tracked.from, tracked.tx.Nonce())
+	}
+}
@@ -2,35 +2,53 @@ package utils
 
 import (
 	"context"
-	"crypto/ecdsa"
-	"encoding/json"
-	"io/ioutil"
+	"fmt"
 	"log"
 	"math/big"
-	"os"
-	"strings"
 	"time"
 
 	"github.com/ethereum/go-ethereum"
-	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/spf13/viper"
 )
 
-const GAS_LIMIT = uint64(3000000) // in units
+const CALL_TIMEOUT = time.Second * 2
+
+// Backend is the part of ethclient.Client the utils use, SimulatedBackend implements it too
+type Backend interface {
+	bind.ContractBackend
+	ethereum.ChainReader
+	ethereum.ChainStateReader
+	ethereum.TransactionReader
+}
 
 type Config struct {
-	Host            string
-	Port            string
-	NodeUrl         string
-	AccountAddress  string
-	PrivateKey      string
-	AniTokenAddress string
-	Client          *ethclient.Client
-	AniABI          abi.ABI
+	Host           string
+	Port           string
+	NodeUrl        string
+	AccountAddress string
+	// Default signer, see Routes for the methods sent by other signers
+	Signer    Signer
+	Signers   map[string]Signer
+	Routes    map[string]SignerRoute
+	ChainId   *big.Int
+	Client    Backend
+	RpcClient *rpc.Client
+	Contracts map[string]Contract
+	Tracker   *TxTracker
+	Nonces    *NonceManager
+	Fees      FeeConfig
+	Gas       GasConfig
+	// Max blocks per eth_getLogs request
+	LogRange uint64
+	// Multicall3 contract batching the view calls, JSON-RPC batches are used when not set
+	Multicall common.Address
+	// Block the view calls read the state at, the latest one when nil. See AtBlock
+	Block *big.Int
 }
 
 var config Config
@@ -44,164 +62,221 @@ func InitConfig(providePath string) error {
 	if err != nil {               // Handle errors reading the config file
 		log.Fatalf("Config: Cannot read ConfigYaml: %v", err)
 	}
-	client, err := ethclient.Dial(viper.GetString("nodeUrl"))
+	rpcClient, err := rpc.Dial(viper.GetString("nodeUrl"))
 	if err != nil {
 		log.Fatalf("Config: Cannot Connect to node url: %v", err)
 	}
-	aniABIC := make(chan abi.ABI)
-	mapResultC := make(chan string)
-	go func() {
-		// Open our jsonFile
-		jsonAbiFile, err := os.Open(providePath + "chain-info/contracts/AniwarToken.json")
-		// if we os.Open returns an error then handle it
-		if err != nil {
-			log.Fatalf("Config: Cannot Open ABI json file: %v", err)
-		}
-		defer jsonAbiFile.Close()
-
-		abiBytes, err := ioutil.ReadAll(jsonAbiFile)
-		if err != nil {
-			log.Fatalf("Config: Cannot read ABI: %v", err)
-		}
-		var result map[string]interface{}
-		err = json.Unmarshal(abiBytes, &result)
-		if err != nil {
-			log.Fatalf("Config: Cannot Unmarshal ABI file json: %v", err)
-		}
-		dataAbiJson, err := json.Marshal(result["abi"])
-		if err != nil {
-			log.Fatalf("Config: Cannot Marshal ABI file json: %v", err)
-		}
-		_aniABI, err := abi.JSON(strings.NewReader(string(dataAbiJson)))
-		if err != nil {
-			log.Fatalf("Config: Cannot pack ABI: %v", err)
-		}
-		aniABIC <- _aniABI
-	}()
-
-	go func() {
-		jsonMapFile, err := os.Open(providePath + "chain-info/deployments/map.json")
-		if err != nil {
-			log.Fatalf("Config: Cannot Open map.json: %v", err)
-		}
-		defer jsonMapFile.Close()
-
-		mapBytes, err := ioutil.ReadAll(jsonMapFile)
-		if err != nil {
-			log.Fatalf("Config: Cannot Read map.json: %v", err)
-		}
+	client := ethclient.NewClient(rpcClient)
+	chainId, err := resolveChainId(client, viper.GetString("chainId"))
+	if err != nil {
+		return err
+	}
+	contracts, err := loadContracts(providePath, chainId.String())
+	if err != nil {
+		return err
+	}
+	fees, err := loadFeeConfig(chainId)
+	if err != nil {
+		return err
+	}
+	gas, err := loadGasConfig(contracts)
+	if err != nil {
+		return err
+	}
 
-		var _mapResult map[string]map[string][]string
-		json.Unmarshal(mapBytes, &_mapResult)
-		mapResultC <- _mapResult["4"]["AniwarToken"][0]
-	}()
+	viper.SetDefault("confirmations", 1)
+	viper.SetDefault("txPollInterval", "3s")
+	viper.SetDefault("txDropTimeout", "5m")
+	viper.SetDefault("logRange", DEFAULT_LOG_RANGE)
 
 	host := viper.GetString("host")
 	port := viper.GetString("port")
 	nodeUrl := viper.GetString("nodeUrl")
+	signers, signer, err := loadSigners()
+	if err != nil {
+		return err
+	}
+	routes, err := loadSignerRoutes(signers, contracts)
+	if err != nil {
+		return err
+	}
 	accountAddress := viper.GetString("accountAddress")
-	privateKey := viper.GetString("privateKey")
+	if accountAddress == "" && signer != nil {
+		accountAddress = signer.Address().Hex()
+	}
 	config = Config{
-		Host:            host,
-		Port:            port,
-		NodeUrl:         nodeUrl,
-		AccountAddress:  accountAddress,
-		PrivateKey:      privateKey,
-		AniTokenAddress: <-mapResultC,
-		Client:          client,
-		AniABI:          <-aniABIC,
+		Host:           host,
+		Port:           port,
+		NodeUrl:        nodeUrl,
+		AccountAddress: accountAddress,
+		Signer:         signer,
+		Signers:        signers,
+		Routes:         routes,
+		ChainId:        chainId,
+		Client:         client,
+		RpcClient:      rpcClient,
+		Contracts:      contracts,
+		Fees:           fees,
+		Gas:            gas,
+		LogRange:       viper.GetUint64("logRange"),
+	}
+	config.Multicall, err = resolveMulticall(client, chainId)
+	if err != nil {
+		return err
+	}
+	config.Nonces = NewNonceManager(client)
+	// The confirmation depth of the chain overrides the global one
+	confirmations := viper.GetUint64("confirmations")
+	if key := "chains." + chainId.String() + ".confirmations"; viper.IsSet(key) {
+		confirmations = viper.GetUint64(key)
+	}
+	config.Tracker = NewTxTracker(config.Client, config.Nonces, confirmations, viper.GetDuration("txPollInterval"), viper.GetDuration("txDropTimeout"))
+
+	viper.SetDefault("verifySignerRoles", true)
+	if viper.GetBool("verifySignerRoles") {
+		return VerifySignerRoles(config)
 	}
 	return nil
 }
+
+// resolveChainId returns the chain id of the node, making sure it matches the configured one if any
+func resolveChainId(client *ethclient.Client, configured string) (*big.Int, error) {
+	d := time.Now().Add(time.Second * 5)
+	ctx, cancel := context.WithDeadline(context.Background(), d)
+	defer cancel()
+
+	nodeChainId, err := client.ChainID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("Config: Cannot get ChainID from node: %v", err)
+	}
+	if configured == "" {
+		return nodeChainId, nil
+	}
+	chainId, ok := new(big.Int).SetString(configured, 10)
+	if !ok {
+		return nil, fmt.Errorf("Config: Invalid chainId %q", configured)
+	}
+	if chainId.Cmp(nodeChainId) != 0 {
+		return nil, fmt.Errorf("Config: chainId %v does not match node chain id %v", chainId, nodeChainId)
+	}
+	return chainId, nil
+}
+
 func GetConfig() (Config, error) {
 	return config, nil
 }
 
-func CallMethods(config Config, methodName string, valueInWei *big.Int, args ...interface{}) *types.Transaction {
-	privateKey, err := crypto.HexToECDSA(config.PrivateKey)
+// SetConfig replaces the config GetConfig returns, the service tests run on a simulated chain
+func SetConfig(c Config) {
+	config = c
+}
+
+// SignerAddress returns the address CallMethods sends methodName of contractName from
+func SignerAddress(config Config, contractName string, methodName string) (common.Address, error) {
+	signer, err := config.SignerFor(contractName, methodName)
 	if err != nil {
-		log.Fatalf("SendMethods: Cannot convert Private key: %v", err)
+		return common.Address{}, err
 	}
+	return signer.Address(), nil
+}
 
-	publicKey := privateKey.Public()
-	publicKeyECDSA, ok := publicKey.(*ecdsa.PublicKey)
-	if !ok {
-		log.Fatal("SendMethods: error casting public key to ECDSA")
+func CallMethods(config Config, contractName string, methodName string, valueInWei *big.Int, args ...interface{}) (*types.Transaction, error) {
+	contract, err := config.GetContract(contractName)
+	if err != nil {
+		return nil, err
 	}
 
-	fromAddress := crypto.PubkeyToAddress(*publicKeyECDSA)
-	d := time.Now().Add(time.Second * 2)
+	signer, err := config.SignerFor(contractName, methodName)
+	if err != nil {
+		return nil, err
+	}
+	fromAddress := signer.Address()
+
+	d := time.Now().Add(CALL_TIMEOUT)
 	ctx, cancel := context.WithDeadline(context.Background(), d)
 	defer cancel()
 
-	toAddress := common.HexToAddress(config.AniTokenAddress)
-
-	gasPrice, err := config.Client.SuggestGasPrice(ctx)
+	data, err := packCall(contract, methodName, args...)
 	if err != nil {
-		log.Fatalf("SendMethods: Gas Price suggest error: %v", err)
+		return nil, err
 	}
 
-	nonce, err := config.Client.PendingNonceAt(ctx, fromAddress)
-	if !ok {
-		log.Fatalf("SendMethods: Nonce pending error: %v", err)
+	gasLimit, err := estimateGas(ctx, config, fromAddress, contract, methodName, valueInWei, data)
+	if err != nil {
+		return nil, err
 	}
 
-	chainID, err := config.Client.NetworkID(ctx)
+	nonce, err := config.Nonces.Next(ctx, fromAddress)
 	if err != nil {
-		log.Fatalf("SendMethods: Cannot get ChainID: %v", err)
+		return nil, err
 	}
 
-	data, err := config.AniABI.Pack(methodName, args...)
+	txData, err := newTxData(ctx, config, nonce, contract.Address, valueInWei, gasLimit, data)
 	if err != nil {
-		log.Fatalf("SendMethods: Cannot Pack Method: %v", err)
+		config.Nonces.Release(fromAddress, nonce)
+		return nil, err
 	}
 
-	tx := types.NewTransaction(nonce, toAddress, valueInWei, GAS_LIMIT, gasPrice, data)
-
-	signedTx, err := types.SignTx(tx, types.NewEIP155Signer(chainID), privateKey)
+	signedTx, err := signer.SignTx(ctx, types.NewTx(txData), config.ChainId)
 	if err != nil {
-		log.Fatalf("SendMethods: Cannot sign transaction: %v", err)
+		config.Nonces.Release(fromAddress, nonce)
+		return nil, fmt.Errorf("SendMethods: Cannot sign transaction: %v", err)
 	}
 
-	err = config.Client.SendTransaction(context.Background(), signedTx)
+	err = config.Client.SendTransaction(ctx, signedTx)
 	if err != nil {
-		log.Fatalf("Cannot send transaction: %v", err)
+		config.Nonces.Failed(fromAddress, nonce, err)
+		return nil, rpcErrorFor(methodName, err)
 	}
+	config.Tracker.Track(methodName, fromAddress, signedTx)
 
-	return signedTx
+	return signedTx, nil
 }
 
-func CallViewMethods(config Config, methodName string, valueInWei *big.Int, args ...interface{}) []interface{} {
+func CallViewMethods(config Config, contractName string, methodName string, valueInWei *big.Int, args ...interface{}) ([]interface{}, error) {
+	contract, err := config.GetContract(contractName)
+	if err != nil {
+		return nil, err
+	}
 
-	d := time.Now().Add(time.Second * 2)
+	d := time.Now().Add(CALL_TIMEOUT)
 	ctx, cancel := context.WithDeadline(context.Background(), d)
 	defer cancel()
 
-	data, err := config.AniABI.Pack(methodName, args...)
+	data, err := packCall(contract, methodName, args...)
 	if err != nil {
-		log.Fatalf("CallViewMethods: Cannot Pack Method: %v", err)
+		return nil, err
 	}
 
-	contractAddress := common.HexToAddress(config.AniTokenAddress)
-	if err != nil {
-		log.Fatalf("CallViewMethods: Cannot convert Contract Address: %v", err)
-	}
+	msg := ethereum.CallMsg{From: common.HexToAddress(config.AccountAddress), To: &contract.Address, Value: valueInWei, Data: data}
 
-	msg := ethereum.CallMsg{From: common.HexToAddress(config.AccountAddress), To: &contractAddress, Value: big.NewInt(0), Data: data}
+	respone, err := config.Client.CallContract(ctx, msg, config.Block)
 	if err != nil {
-		log.Fatalf("CallViewMethods: Cannot convert Msg: %v", err)
+		return nil, rpcErrorFor(methodName, err)
 	}
 
-	respone, err := config.Client.CallContract(ctx, msg, nil)
+	result, err := contract.ABI.Unpack(methodName, respone)
 	if err != nil {
-		log.Fatalf("CallViewMethods: Cannot Call Contract: %v", err)
+		return nil, &UnpackError{Method: methodName, Err: err}
 	}
 
-	var result []interface{}
-	result, err = config.AniABI.Unpack(methodName, respone)
+	return result, nil
+}
+
+// WaitMined blocks until tx is mined and returns its receipt, or a RevertError if it failed
+func WaitMined(ctx context.Context, config Config, methodName string, tx *types.Transaction) (*types.Receipt, error) {
+	state, err := config.Tracker.Wait(ctx, tx.Hash(), true)
 	if err != nil {
-		log.Fatalf("CallViewMethods: Cannot Unpack Result: %v", err)
+		return nil, err
 	}
-
-	return result
+	switch state.Status {
+	case TX_REVERTED:
+		if state.RevertErr != nil {
+			return state.Receipt, state.RevertErr
+		}
+		return state.Receipt, &RevertError{Method: methodName}
+	case TX_REPLACED, TX_DROPPED:
+		return nil, &RpcError{Method: methodName, Err: fmt.Errorf("transaction %s was %v", tx.Hash().Hex(), state.Status)}
+	}
+	return state.Receipt, nil
 }
@@ -0,0 +1,100 @@
+// Package utilstest runs the chain-info contracts on a simulated chain for the tests of the backend
+// packages. It does not import utils so the utils tests can use it too
+package utilstest
+
+import (
+	"crypto/ecdsa"
+	"encoding/json"
+	"io/ioutil"
+	"math/big"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+const GAS_LIMIT = 1_000_000_000
+
+// Chain is a simulated chain whose deployer holds 1000 ether
+type Chain struct {
+	*backends.SimulatedBackend
+	Key      *ecdsa.PrivateKey
+	Deployer common.Address
+	Auth     *bind.TransactOpts
+}
+
+func NewChain(t *testing.T) *Chain {
+	t.Helper()
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	deployer := crypto.PubkeyToAddress(key.PublicKey)
+	sim := backends.NewSimulatedBackend(core.GenesisAlloc{
+		deployer: {Balance: new(big.Int).Mul(big.NewInt(1000), big.NewInt(params.Ether))},
+	}, GAS_LIMIT)
+	t.Cleanup(func() { sim.Close() })
+	auth, err := bind.NewKeyedTransactorWithChainID(key, params.AllEthashProtocolChanges.ChainID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &Chain{SimulatedBackend: sim, Key: key, Deployer: deployer, Auth: auth}
+}
+
+// ChainId is the chain id of the simulated chain
+func ChainId() *big.Int {
+	return params.AllEthashProtocolChanges.ChainID
+}
+
+// Artifact reads the abi and bytecode of a brownie build artifact of chain-info/contracts
+func Artifact(t *testing.T, name string) (abi.ABI, []byte) {
+	t.Helper()
+	// chain-info is two directories above this file, whatever the package under test
+	_, file, _, _ := runtime.Caller(0)
+	artifactBytes, err := ioutil.ReadFile(filepath.Join(filepath.Dir(file), "..", "..", "chain-info", "contracts", name+".json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	artifact := struct {
+		ABI      json.RawMessage `json:"abi"`
+		Bytecode string          `json:"bytecode"`
+	}{}
+	if err := json.Unmarshal(artifactBytes, &artifact); err != nil {
+		t.Fatal(err)
+	}
+	contractABI, err := abi.JSON(strings.NewReader(string(artifact.ABI)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return contractABI, common.FromHex(artifact.Bytecode)
+}
+
+// Deploy deploys a chain-info contract from the deployer and mines it
+func (c *Chain) Deploy(t *testing.T, name string, args ...interface{}) (common.Address, abi.ABI) {
+	t.Helper()
+	contractABI, bytecode := Artifact(t, name)
+	address, _, _, err := bind.DeployContract(c.Auth, contractABI, bytecode, c.SimulatedBackend, args...)
+	if err != nil {
+		t.Fatalf("Deploy %s: %v", name, err)
+	}
+	c.Commit()
+	return address, contractABI
+}
+
+// Transact sends a method from the deployer and mines it
+func (c *Chain) Transact(t *testing.T, address common.Address, contractABI abi.ABI, method string, args ...interface{}) {
+	t.Helper()
+	bound := bind.NewBoundContract(address, contractABI, c.SimulatedBackend, c.SimulatedBackend, c.SimulatedBackend)
+	if _, err := bound.Transact(c.Auth, method, args...); err != nil {
+		t.Fatalf("%s: %v", method, err)
+	}
+	c.Commit()
+}